@@ -6,11 +6,16 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 
 	oraclekeeper "github.com/irisnet/irismod/modules/oracle/keeper"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
 
+	blacklistkeeper "github.com/irisnet/irishub/modules/blacklist/keeper"
+	dustsweepkeeper "github.com/irisnet/irishub/modules/dustsweep/keeper"
+	featuregatekeeper "github.com/irisnet/irishub/modules/featuregate/keeper"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+	poolwhitelistkeeper "github.com/irisnet/irishub/modules/poolwhitelist/keeper"
 )
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -22,6 +27,11 @@ func NewAnteHandler(
 	tk tokenkeeper.Keeper,
 	ok oraclekeeper.Keeper,
 	gk guardiankeeper.Keeper,
+	fk featuregatekeeper.Keeper,
+	blk blacklistkeeper.Keeper,
+	pwk poolwhitelistkeeper.Keeper,
+	dsk dustsweepkeeper.Keeper,
+	pk paramskeeper.Keeper,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
@@ -36,13 +46,21 @@ func NewAnteHandler(
 		ante.NewRejectFeeGranterDecorator(),
 		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(ak),
+		NewValidateBlacklistDecorator(blk), // must be called before the fees are deducted
 		ante.NewDeductFeeDecorator(ak, bk),
 		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		ante.NewSigVerificationDecorator(ak, signModeHandler),
+		NewDustSweepActivityDecorator(dsk),
 		NewValidateTokenDecorator(tk),
+		NewValidateSendEnabledDecorator(bk, tk),
+		NewValidatePoolWhitelistDecorator(pwk, bk),
 		tokenkeeper.NewValidateTokenFeeDecorator(tk, bk),
 		oraclekeeper.NewValidateOracleAuthDecorator(ok, gk),
-		NewValidateServiceDecorator(),
+		NewValidateServiceDecorator(fk),
+		NewValidateTextDecorator(fk),
+		NewValidateParamChangesDecorator(pk),
+		NewValidateParamBoundsDecorator(),
+		NewValidateUpgradePlanDecorator(),
 		ante.NewIncrementSequenceDecorator(ak),
 	)
 }
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"io"
 	"os"
 	"path/filepath"
@@ -106,12 +107,49 @@ import (
 	"github.com/irisnet/irishub/address"
 	irisappparams "github.com/irisnet/irishub/app/params"
 	"github.com/irisnet/irishub/lite"
+	"github.com/irisnet/irishub/modules/blacklist"
+	blacklistkeeper "github.com/irisnet/irishub/modules/blacklist/keeper"
+	blacklisttypes "github.com/irisnet/irishub/modules/blacklist/types"
+	"github.com/irisnet/irishub/modules/blocktime"
+	blocktimekeeper "github.com/irisnet/irishub/modules/blocktime/keeper"
+	blocktimetypes "github.com/irisnet/irishub/modules/blocktime/types"
+	"github.com/irisnet/irishub/modules/dryrun"
+	dryrunkeeper "github.com/irisnet/irishub/modules/dryrun/keeper"
+	"github.com/irisnet/irishub/modules/dustsweep"
+	dustsweepkeeper "github.com/irisnet/irishub/modules/dustsweep/keeper"
+	dustsweeptypes "github.com/irisnet/irishub/modules/dustsweep/types"
+	"github.com/irisnet/irishub/modules/escrow"
+	escrowkeeper "github.com/irisnet/irishub/modules/escrow/keeper"
+	"github.com/irisnet/irishub/modules/faucet"
+	faucetkeeper "github.com/irisnet/irishub/modules/faucet/keeper"
+	faucettypes "github.com/irisnet/irishub/modules/faucet/types"
+	"github.com/irisnet/irishub/modules/featuregate"
+	featuregatekeeper "github.com/irisnet/irishub/modules/featuregate/keeper"
+	featuregatetypes "github.com/irisnet/irishub/modules/featuregate/types"
+	"github.com/irisnet/irishub/modules/govdeposit"
+	govdepositkeeper "github.com/irisnet/irishub/modules/govdeposit/keeper"
+	govdeposittypes "github.com/irisnet/irishub/modules/govdeposit/types"
+	"github.com/irisnet/irishub/modules/govquorum"
+	govquorumkeeper "github.com/irisnet/irishub/modules/govquorum/keeper"
+	govquorumtypes "github.com/irisnet/irishub/modules/govquorum/types"
 	"github.com/irisnet/irishub/modules/guardian"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	"github.com/irisnet/irishub/modules/mint"
 	mintkeeper "github.com/irisnet/irishub/modules/mint/keeper"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/modules/paramhistory"
+	paramhistorykeeper "github.com/irisnet/irishub/modules/paramhistory/keeper"
+	paramhistorytypes "github.com/irisnet/irishub/modules/paramhistory/types"
+	"github.com/irisnet/irishub/modules/payout"
+	payoutkeeper "github.com/irisnet/irishub/modules/payout/keeper"
+	payouttypes "github.com/irisnet/irishub/modules/payout/types"
+	"github.com/irisnet/irishub/modules/poolwhitelist"
+	poolwhitelistkeeper "github.com/irisnet/irishub/modules/poolwhitelist/keeper"
+	poolwhitelisttypes "github.com/irisnet/irishub/modules/poolwhitelist/types"
+	"github.com/irisnet/irishub/modules/signal"
+	signalclient "github.com/irisnet/irishub/modules/signal/client"
+	signaltypes "github.com/irisnet/irishub/modules/signal/types"
 )
 
 const appName = "IrisApp"
@@ -133,6 +171,7 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			signalclient.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -147,10 +186,23 @@ var (
 		record.AppModuleBasic{},
 		nft.AppModuleBasic{},
 		htlc.AppModuleBasic{},
-		coinswap.AppModuleBasic{},
+		coinswapModuleBasic{},
 		service.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		random.AppModuleBasic{},
+		featuregate.AppModuleBasic{},
+		paramhistory.AppModuleBasic{},
+		faucet.AppModuleBasic{},
+		dryrun.AppModuleBasic{},
+		signal.AppModuleBasic{},
+		blacklist.AppModuleBasic{},
+		poolwhitelist.AppModuleBasic{},
+		blocktime.AppModuleBasic{},
+		escrow.AppModuleBasic{},
+		payout.AppModuleBasic{},
+		govdeposit.AppModuleBasic{},
+		govquorum.AppModuleBasic{},
+		dustsweep.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -168,9 +220,11 @@ var (
 		servicetypes.DepositAccName:    {authtypes.Burner},
 		servicetypes.RequestAccName:    nil,
 		servicetypes.TaxAccName:        {authtypes.Burner},
+		faucettypes.ModuleName:         nil,
 	}
 
-	// module accounts that are allowed to receive tokens
+	// module accounts that are allowed to receive tokens; the faucet is funded
+	// through MsgFundFaucet only
 	allowedReceivingModAcc = map[string]bool{
 		distrtypes.ModuleName: true,
 	}
@@ -228,11 +282,30 @@ type IrisApp struct {
 	oracleKeeper   oraclekeeper.Keeper
 	randomKeeper   randomkeeper.Keeper
 
+	featureGateKeeper   featuregatekeeper.Keeper
+	blacklistKeeper     blacklistkeeper.Keeper
+	poolWhitelistKeeper poolwhitelistkeeper.Keeper
+	blockTimeKeeper     blocktimekeeper.Keeper
+	faucetKeeper        faucetkeeper.Keeper
+	paramHistoryKeeper  paramhistorykeeper.Keeper
+	dryRunKeeper        dryrunkeeper.Keeper
+	escrowKeeper        escrowkeeper.Keeper
+	payoutKeeper        payoutkeeper.Keeper
+	govDepositKeeper    govdepositkeeper.Keeper
+	govQuorumKeeper     govquorumkeeper.Keeper
+	dustSweepKeeper     dustsweepkeeper.Keeper
+
 	// the module manager
 	mm *module.Manager
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// signs the API query responses, nil if disabled
+	responseSigner *ResponseSigner
+
+	// posts service responses to the registered webhooks, nil if disabled
+	serviceWebhooks *ServiceWebhooks
 }
 
 func init() {
@@ -296,6 +369,8 @@ func NewIrisApp(
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
+		paramhistorytypes.StoreKey, faucettypes.StoreKey, blocktimetypes.StoreKey, payouttypes.StoreKey,
+		govquorumtypes.StoreKey, dustsweeptypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -329,14 +404,19 @@ func NewIrisApp(
 		appCodec, keys[banktypes.StoreKey], app.accountKeeper, app.GetSubspace(banktypes.ModuleName), app.BlockedAddrs(),
 	)
 	stakingKeeper := stakingkeeper.NewKeeper(
-		appCodec, keys[stakingtypes.StoreKey], app.accountKeeper, app.bankKeeper, app.GetSubspace(stakingtypes.ModuleName),
+		appCodec, keys[stakingtypes.StoreKey], app.accountKeeper,
+		newCoinFlowBankKeeper(app.bankKeeper, stakingtypes.ModuleName), app.GetSubspace(stakingtypes.ModuleName),
 	)
+	app.featureGateKeeper = featuregatekeeper.NewKeeper(app.GetSubspace(featuregatetypes.ModuleName))
+	app.blockTimeKeeper = blocktimekeeper.NewKeeper(keys[blocktimetypes.StoreKey])
 	app.mintKeeper = mintkeeper.NewKeeper(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName),
-		app.accountKeeper, app.bankKeeper, authtypes.FeeCollectorName,
+		app.accountKeeper, newCoinFlowBankKeeper(app.bankKeeper, minttypes.ModuleName),
+		newMintBlockTimeOracle(app.featureGateKeeper, app.blockTimeKeeper), authtypes.FeeCollectorName,
 	)
 	app.distrKeeper = distrkeeper.NewKeeper(
-		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.accountKeeper, app.bankKeeper,
+		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.accountKeeper,
+		newCoinFlowBankKeeper(app.bankKeeper, distrtypes.ModuleName),
 		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
 	)
 	app.slashingKeeper = slashingkeeper.NewKeeper(
@@ -358,17 +438,28 @@ func NewIrisApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.stakingKeeper, scopedIBCKeeper,
 	)
 
+	app.paramHistoryKeeper = paramhistorykeeper.NewKeeper(
+		appCodec, keys[paramhistorytypes.StoreKey], app.paramsKeeper,
+	)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(paramproposal.RouterKey, NewParamChangeProposalHandler(
+			app.paramsKeeper, paramhistory.NewParamChangeProposalHandler(
+				app.paramHistoryKeeper, params.NewParamChangeProposalHandler(app.paramsKeeper),
+			),
+		)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(signaltypes.RouterKey, signal.NewProposalHandler()).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper))
 	app.govKeeper = govkeeper.NewKeeper(
-		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.accountKeeper, app.bankKeeper,
+		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.accountKeeper,
+		newCoinFlowBankKeeper(app.bankKeeper, govtypes.ModuleName),
 		&stakingKeeper, govRouter,
 	)
+	app.dryRunKeeper = dryrunkeeper.NewKeeper(app.govKeeper)
 
 	// Create Transfer Keepers
 	app.transferKeeper = ibctransferkeeper.NewKeeper(
@@ -390,23 +481,33 @@ func NewIrisApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.evidenceKeeper = *evidenceKeeper
 
+	app.blacklistKeeper = blacklistkeeper.NewKeeper(app.GetSubspace(blacklisttypes.ModuleName))
+	app.poolWhitelistKeeper = poolwhitelistkeeper.NewKeeper(app.GetSubspace(poolwhitelisttypes.ModuleName))
+	app.faucetKeeper = faucetkeeper.NewKeeper(
+		appCodec, keys[faucettypes.StoreKey], app.GetSubspace(faucettypes.ModuleName),
+		app.accountKeeper, newCoinFlowBankKeeper(app.bankKeeper, faucettypes.ModuleName),
+	)
 	app.guardianKeeper = guardiankeeper.NewKeeper(appCodec, keys[guardiantypes.StoreKey])
 	app.tokenKeeper = tokenkeeper.NewKeeper(
 		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
-		app.bankKeeper, authtypes.FeeCollectorName,
+		newCoinFlowBankKeeper(app.bankKeeper, tokentypes.ModuleName), authtypes.FeeCollectorName,
 	)
 	app.recordKeeper = recordkeeper.NewKeeper(appCodec, keys[recordtypes.StoreKey])
 	app.nftKeeper = nftkeeper.NewKeeper(appCodec, keys[nfttypes.StoreKey])
 
-	app.htlcKeeper = htlckeeper.NewKeeper(appCodec, keys[htlctypes.StoreKey], app.accountKeeper, app.bankKeeper)
+	app.htlcKeeper = htlckeeper.NewKeeper(
+		appCodec, keys[htlctypes.StoreKey], app.accountKeeper,
+		newCoinFlowBankKeeper(app.bankKeeper, htlctypes.ModuleName),
+	)
 
 	app.coinswapKeeper = coinswapkeeper.NewKeeper(
 		appCodec, keys[coinswaptypes.StoreKey], app.GetSubspace(coinswaptypes.ModuleName),
-		app.bankKeeper, app.accountKeeper,
+		newCoinFlowBankKeeper(app.bankKeeper, coinswaptypes.ModuleName), app.accountKeeper,
 	)
 
 	app.serviceKeeper = servicekeeper.NewKeeper(
-		appCodec, keys[servicetypes.StoreKey], app.accountKeeper, app.bankKeeper,
+		appCodec, keys[servicetypes.StoreKey], app.accountKeeper,
+		newCoinFlowBankKeeper(app.bankKeeper, servicetypes.ModuleName),
 		app.GetSubspace(servicetypes.ModuleName), servicetypes.TaxAccName,
 	)
 
@@ -415,7 +516,28 @@ func NewIrisApp(
 		app.serviceKeeper,
 	)
 
-	app.randomKeeper = randomkeeper.NewKeeper(appCodec, keys[randomtypes.StoreKey], app.bankKeeper, app.serviceKeeper)
+	app.randomKeeper = randomkeeper.NewKeeper(
+		appCodec, keys[randomtypes.StoreKey],
+		newCoinFlowBankKeeper(app.bankKeeper, randomtypes.ModuleName), app.serviceKeeper,
+	)
+
+	app.escrowKeeper = escrowkeeper.NewKeeper(
+		app.accountKeeper, app.bankKeeper, app.distrKeeper, app.govKeeper, app.serviceKeeper, app.htlcKeeper,
+	)
+
+	app.payoutKeeper = payoutkeeper.NewKeeper(appCodec, keys[payouttypes.StoreKey], app.GetSubspace(payouttypes.ModuleName))
+	app.govDepositKeeper = govdepositkeeper.NewKeeper(
+		app.GetSubspace(govdeposittypes.ModuleName), newCoinFlowBankKeeper(app.bankKeeper, govtypes.ModuleName), app.govKeeper,
+	)
+	app.govQuorumKeeper = govquorumkeeper.NewKeeper(
+		appCodec, keys[govquorumtypes.StoreKey], app.GetSubspace(govquorumtypes.ModuleName), app.govKeeper,
+	)
+	app.dustSweepKeeper = dustsweepkeeper.NewKeeper(
+		appCodec, keys[dustsweeptypes.StoreKey], app.GetSubspace(dustsweeptypes.ModuleName), app.bankKeeper, app.distrKeeper,
+	)
+
+	app.responseSigner = loadResponseSigner(logger, homePath, appOpts)
+	app.serviceWebhooks = loadServiceWebhooks(logger, appOpts)
 
 	/****  Module Options ****/
 	var skipGenesisInvariants = false
@@ -436,7 +558,10 @@ func NewIrisApp(
 		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
 		capability.NewAppModule(appCodec, *app.capabilityKeeper),
 		crisis.NewAppModule(&app.crisisKeeper, skipGenesisInvariants),
-		gov.NewAppModule(appCodec, app.govKeeper, app.accountKeeper, app.bankKeeper),
+		newGovModule(
+			gov.NewAppModule(appCodec, app.govKeeper, app.accountKeeper, app.bankKeeper),
+			app.govKeeper, app.govDepositKeeper, app.govQuorumKeeper,
+		),
 		mint.NewAppModule(appCodec, app.mintKeeper),
 		slashing.NewAppModule(appCodec, app.slashingKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper),
 		distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper),
@@ -451,10 +576,28 @@ func NewIrisApp(
 		record.NewAppModule(appCodec, app.recordKeeper, app.accountKeeper, app.bankKeeper),
 		nft.NewAppModule(appCodec, app.nftKeeper, app.accountKeeper, app.bankKeeper),
 		htlc.NewAppModule(appCodec, app.htlcKeeper, app.accountKeeper, app.bankKeeper),
-		coinswap.NewAppModule(appCodec, app.coinswapKeeper, app.accountKeeper, app.bankKeeper),
-		service.NewAppModule(appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
+		newCoinswapModule(
+			coinswap.NewAppModule(appCodec, app.coinswapKeeper, app.accountKeeper, app.bankKeeper),
+			app.coinswapKeeper, app.accountKeeper, app.bankKeeper, app.featureGateKeeper,
+		),
+		newServiceModule(
+			service.NewAppModule(appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
+			app.serviceKeeper, app.payoutKeeper,
+		),
 		oracle.NewAppModule(appCodec, app.oracleKeeper),
 		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper),
+		featuregate.NewAppModule(appCodec, app.featureGateKeeper),
+		paramhistory.NewAppModule(appCodec, app.paramHistoryKeeper),
+		faucet.NewAppModule(appCodec, app.faucetKeeper),
+		dryrun.NewAppModule(app.dryRunKeeper),
+		blacklist.NewAppModule(appCodec, app.blacklistKeeper),
+		poolwhitelist.NewAppModule(appCodec, app.poolWhitelistKeeper),
+		blocktime.NewAppModule(app.blockTimeKeeper),
+		escrow.NewAppModule(app.escrowKeeper),
+		payout.NewAppModule(appCodec, app.payoutKeeper),
+		govdeposit.NewAppModule(appCodec, app.govDepositKeeper),
+		govquorum.NewAppModule(appCodec, app.govQuorumKeeper),
+		dustsweep.NewAppModule(appCodec, app.dustSweepKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -462,13 +605,13 @@ func NewIrisApp(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName,
+		upgradetypes.ModuleName, blocktimetypes.ModuleName, payouttypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName,
 		slashingtypes.ModuleName, evidencetypes.ModuleName, stakingtypes.ModuleName,
 		ibchost.ModuleName, htlctypes.ModuleName, randomtypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
-		servicetypes.ModuleName,
+		servicetypes.ModuleName, dustsweeptypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -482,6 +625,9 @@ func NewIrisApp(
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
 		guardiantypes.ModuleName, tokentypes.ModuleName, nfttypes.ModuleName, htlctypes.ModuleName, recordtypes.ModuleName,
 		coinswaptypes.ModuleName, servicetypes.ModuleName, oracletypes.ModuleName, randomtypes.ModuleName,
+		featuregatetypes.ModuleName, paramhistorytypes.ModuleName, faucettypes.ModuleName, blacklisttypes.ModuleName,
+		poolwhitelisttypes.ModuleName, payouttypes.ModuleName, govdeposittypes.ModuleName, govquorumtypes.ModuleName,
+		dustsweeptypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -510,7 +656,10 @@ func NewIrisApp(
 		record.NewAppModule(appCodec, app.recordKeeper, app.accountKeeper, app.bankKeeper),
 		nft.NewAppModule(appCodec, app.nftKeeper, app.accountKeeper, app.bankKeeper),
 		htlc.NewAppModule(appCodec, app.htlcKeeper, app.accountKeeper, app.bankKeeper),
-		coinswap.NewAppModule(appCodec, app.coinswapKeeper, app.accountKeeper, app.bankKeeper),
+		newCoinswapModule(
+			coinswap.NewAppModule(appCodec, app.coinswapKeeper, app.accountKeeper, app.bankKeeper),
+			app.coinswapKeeper, app.accountKeeper, app.bankKeeper, app.featureGateKeeper,
+		),
 		service.NewAppModule(appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
 		oracle.NewAppModule(appCodec, app.oracleKeeper),
 		random.NewAppModule(appCodec, app.randomKeeper, app.accountKeeper, app.bankKeeper),
@@ -532,11 +681,18 @@ func NewIrisApp(
 		app.tokenKeeper,
 		app.oracleKeeper,
 		app.guardianKeeper,
+		app.featureGateKeeper,
+		app.blacklistKeeper,
+		app.poolWhitelistKeeper,
+		app.dustSweepKeeper,
+		app.paramsKeeper,
 		ante.DefaultSigVerificationGasConsumer,
 		encodingConfig.TxConfig.SignModeHandler(),
 	))
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.registerUpgradeHandlers()
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
@@ -572,6 +728,9 @@ func (app *IrisApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *IrisApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if app.responseSigner != nil {
+		app.responseSigner.RecordAppHash(req.Header.Height-1, req.Header.AppHash)
+	}
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -697,6 +856,17 @@ func (app *IrisApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 	if apiConfig.Swagger {
 		lite.RegisterSwaggerAPI(clientCtx, apiSvr.Router)
 	}
+
+	if app.responseSigner != nil {
+		apiSvr.Router.Use(app.responseSigner.Middleware())
+	}
+
+	if app.serviceWebhooks != nil {
+		app.serviceWebhooks.RegisterRoutes(clientCtx, apiSvr.Router)
+		if err := app.serviceWebhooks.Start(context.Background(), clientCtx); err != nil {
+			app.Logger().Error("failed to start the service webhooks", "err", err)
+		}
+	}
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
@@ -737,6 +907,14 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(coinswaptypes.ModuleName)
 	paramsKeeper.Subspace(servicetypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(featuregatetypes.ModuleName)
+	paramsKeeper.Subspace(faucettypes.ModuleName)
+	paramsKeeper.Subspace(blacklisttypes.ModuleName)
+	paramsKeeper.Subspace(poolwhitelisttypes.ModuleName)
+	paramsKeeper.Subspace(payouttypes.ModuleName)
+	paramsKeeper.Subspace(govdeposittypes.ModuleName)
+	paramsKeeper.Subspace(govquorumtypes.ModuleName)
+	paramsKeeper.Subspace(dustsweeptypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -4,14 +4,24 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	dustsweeptypes "github.com/irisnet/irishub/modules/dustsweep/types"
+	faucettypes "github.com/irisnet/irishub/modules/faucet/types"
+	govquorumtypes "github.com/irisnet/irishub/modules/govquorum/types"
 )
 
 func TestIrisAppExport(t *testing.T) {
@@ -51,3 +61,134 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestExportDelegatorShares(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	genesisState := NewDefaultGenesisState()
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+
+	app.InitChain(
+		abci.RequestInitChain{
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+	app.Commit()
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	delAddr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	delAddr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	validator, err := stakingtypes.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	// 2 tokens per share
+	validator.Tokens = sdk.NewInt(200)
+	validator.DelegatorShares = sdk.NewDec(100)
+	app.stakingKeeper.SetValidator(ctx, validator)
+
+	app.stakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr1, valAddr, sdk.NewDec(60)))
+	app.stakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr2, valAddr, sdk.NewDec(40)))
+	app.stakingKeeper.SetUnbondingDelegation(ctx, stakingtypes.NewUnbondingDelegation(delAddr2, valAddr, 1, time.Now(), sdk.NewInt(30)))
+
+	valAddr2 := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	validator2, err := stakingtypes.NewValidator(valAddr2, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	// 1.5 tokens per share, e.g. after a slash
+	validator2.Tokens = sdk.NewInt(150)
+	validator2.DelegatorShares = sdk.NewDec(100)
+	app.stakingKeeper.SetValidator(ctx, validator2)
+
+	// delAddr1 redelegated 40 tokens to valAddr2 for 20 shares, now worth 30 tokens
+	app.stakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr1, valAddr2, sdk.NewDec(20)))
+	app.stakingKeeper.SetRedelegation(ctx, stakingtypes.NewRedelegation(delAddr1, valAddr, valAddr2, 1, time.Now(), sdk.NewInt(40), sdk.NewDec(20)))
+
+	shares := app.ExportDelegatorShares()
+	require.Len(t, shares, 3)
+	for i := 1; i < len(shares); i++ {
+		require.True(t, shares[i-1].Delegator < shares[i].Delegator ||
+			(shares[i-1].Delegator == shares[i].Delegator && shares[i-1].Validator < shares[i].Validator))
+	}
+
+	expected := map[string]DelegatorShare{
+		delAddr1.String() + valAddr.String():  {delAddr1.String(), valAddr.String(), sdk.NewDec(60), sdk.NewInt(120), sdk.ZeroInt(), sdk.ZeroInt()},
+		delAddr1.String() + valAddr2.String(): {delAddr1.String(), valAddr2.String(), sdk.NewDec(20), sdk.NewInt(30), sdk.ZeroInt(), sdk.NewInt(30)},
+		delAddr2.String() + valAddr.String():  {delAddr2.String(), valAddr.String(), sdk.NewDec(40), sdk.NewInt(80), sdk.NewInt(30), sdk.ZeroInt()},
+	}
+	for _, share := range shares {
+		require.Equal(t, expected[share.Delegator+share.Validator], share)
+	}
+}
+
+func TestIrisAppExportWithHardForkPlan(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	genesisState := NewDefaultGenesisState()
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+
+	app.InitChain(
+		abci.RequestInitChain{
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+	app.Commit()
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	err = app.upgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{
+		Name:   "fork",
+		Height: app.LastBlockHeight() + 1,
+		Info:   `{"export":{"for_zero_height":true}}`,
+	})
+	require.NoError(t, err)
+
+	exported, err := app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), exported.Height)
+}
+
+func TestParseHardForkExport(t *testing.T) {
+	export, err := ParseHardForkExport("https://example.com/upgrade.json")
+	require.NoError(t, err)
+	require.Nil(t, export)
+
+	export, err = ParseHardForkExport(`{"binaries":{}}`)
+	require.NoError(t, err)
+	require.Nil(t, export)
+
+	export, err = ParseHardForkExport(`{"export":{"for_zero_height":true}}`)
+	require.NoError(t, err)
+	require.Equal(t, &HardForkExport{ForZeroHeight: true}, export)
+
+	_, err = ParseHardForkExport(`{"export":{"jail_allowed_addrs":["invalid"]}}`)
+	require.Error(t, err)
+
+	for _, info := range []string{
+		`{"export":5}`,
+		`{"export":null}`,
+		`{"export":{"for_zero_height":"yes"}}`,
+		`{"export":{"for_zero_hieght":true}}`,
+	} {
+		_, err = ParseHardForkExport(info)
+		require.Error(t, err, info)
+	}
+}
+
+func TestUpgradeHandler(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	// the stores of the added modules are empty before the upgrade
+	ctx := app.NewContext(true, tmproto.Header{Height: 1})
+	app.upgradeHandler(ctx, upgradetypes.Plan{Name: UpgradeName, Height: 1})
+
+	require.Equal(t, faucettypes.DefaultParams(), app.faucetKeeper.GetParamSet(ctx))
+	require.Equal(t, govquorumtypes.DefaultParams(), app.govQuorumKeeper.GetParamSet(ctx))
+	require.Equal(t, dustsweeptypes.DefaultParams(), app.dustSweepKeeper.GetParamSet(ctx))
+}
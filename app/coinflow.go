@@ -0,0 +1,188 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+)
+
+// coin flow event types and attributes
+const (
+	EventTypeCoinFlow = "coin_flow"
+
+	AttributeKeyFlowType  = "flow_type"
+	AttributeKeyRecipient = "recipient"
+)
+
+// Flow types tagged on the coin movements initiated by module keepers
+const (
+	FlowTypeServiceFeeEscrow = "service-fee-escrow"
+	FlowTypeServiceFee       = "service-fee"
+	FlowTypeTax              = "tax"
+	FlowTypeDeposit          = "deposit"
+	FlowTypeRefund           = "refund"
+	FlowTypeSlash            = "slash"
+	FlowTypeDelegation       = "delegation"
+	FlowTypeUndelegation     = "undelegation"
+	FlowTypeReward           = "reward"
+	FlowTypeMint             = "mint"
+	FlowTypeBurn             = "burn"
+	FlowTypeDepositBurn      = "deposit-burn"
+	FlowTypeTransfer         = "transfer"
+)
+
+// coinFlowBankKeeper wraps the bank keeper handed to a module keeper and emits
+// a coin_flow event, tagged with the flow type and the initiating module, for
+// every coin movement the module keeper makes.
+type coinFlowBankKeeper struct {
+	bankkeeper.Keeper
+
+	module string
+}
+
+func newCoinFlowBankKeeper(bk bankkeeper.Keeper, module string) coinFlowBankKeeper {
+	return coinFlowBankKeeper{Keeper: bk, module: module}
+}
+
+// SendCoins implements bankkeeper.Keeper
+func (k coinFlowBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	k.emitCoinFlow(ctx, FlowTypeTransfer, fromAddr, toAddr, amt)
+	return nil
+}
+
+// SendCoinsFromModuleToAccount implements bankkeeper.Keeper
+func (k coinFlowBankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	if err := k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+	k.emitCoinFlow(ctx, coinFlowType(senderModule, ""), authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+	return nil
+}
+
+// SendCoinsFromModuleToModule implements bankkeeper.Keeper
+func (k coinFlowBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	if err := k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt); err != nil {
+		return err
+	}
+	k.emitCoinFlow(
+		ctx, coinFlowType(senderModule, recipientModule),
+		authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt,
+	)
+	return nil
+}
+
+// SendCoinsFromAccountToModule implements bankkeeper.Keeper
+func (k coinFlowBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	if err := k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+	k.emitCoinFlow(ctx, coinFlowType("", recipientModule), senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+	return nil
+}
+
+// DelegateCoinsFromAccountToModule implements bankkeeper.Keeper
+func (k coinFlowBankKeeper) DelegateCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	if err := k.Keeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+	k.emitCoinFlow(ctx, FlowTypeDelegation, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+	return nil
+}
+
+// UndelegateCoinsFromModuleToAccount implements bankkeeper.Keeper
+func (k coinFlowBankKeeper) UndelegateCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	if err := k.Keeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+	k.emitCoinFlow(ctx, FlowTypeUndelegation, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+	return nil
+}
+
+// MintCoins implements bankkeeper.Keeper
+func (k coinFlowBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if err := k.Keeper.MintCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+	k.emitCoinFlow(ctx, FlowTypeMint, nil, authtypes.NewModuleAddress(moduleName), amt)
+	return nil
+}
+
+// BurnCoins implements bankkeeper.Keeper
+func (k coinFlowBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	if err := k.Keeper.BurnCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+	k.emitCoinFlow(ctx, burnFlowType(moduleName), authtypes.NewModuleAddress(moduleName), nil, amt)
+	return nil
+}
+
+func (k coinFlowBankKeeper) emitCoinFlow(ctx sdk.Context, flowType string, sender, recipient sdk.AccAddress, amt sdk.Coins) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyFlowType, flowType),
+		sdk.NewAttribute(sdk.AttributeKeyModule, k.module),
+	}
+	if !sender.Empty() {
+		attributes = append(attributes, sdk.NewAttribute(sdk.AttributeKeySender, sender.String()))
+	}
+	if !recipient.Empty() {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyRecipient, recipient.String()))
+	}
+	attributes = append(attributes, sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeCoinFlow, attributes...))
+}
+
+// coinFlowType classifies a transfer by the module accounts it moves coins
+// between, an empty name standing for an account that is not a module account.
+func coinFlowType(sender, recipient string) string {
+	switch {
+	case sender == servicetypes.RequestAccName && recipient == servicetypes.TaxAccName:
+		return FlowTypeTax
+	case recipient == servicetypes.RequestAccName:
+		return FlowTypeServiceFeeEscrow
+	case sender == servicetypes.RequestAccName:
+		return FlowTypeServiceFee
+	case sender == servicetypes.TaxAccName:
+		return FlowTypeTax
+	case recipient == servicetypes.DepositAccName, recipient == govtypes.ModuleName:
+		return FlowTypeDeposit
+	case sender == servicetypes.DepositAccName, sender == govtypes.ModuleName:
+		return FlowTypeRefund
+	case sender == authtypes.FeeCollectorName && recipient == distrtypes.ModuleName:
+		return FlowTypeReward
+	case sender == distrtypes.ModuleName && recipient == "":
+		return FlowTypeReward
+	default:
+		return FlowTypeTransfer
+	}
+}
+
+// burnFlowType classifies a burn by the module account the coins are burned
+// from: the staking pools and the service deposits are slashed, while the gov
+// deposits are burned by the deposit policy rather than as a penalty.
+func burnFlowType(module string) string {
+	switch module {
+	case stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, servicetypes.DepositAccName:
+		return FlowTypeSlash
+	case govtypes.ModuleName:
+		return FlowTypeDepositBurn
+	default:
+		return FlowTypeBurn
+	}
+}
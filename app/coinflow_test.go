@@ -0,0 +1,115 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestCoinFlowType(t *testing.T) {
+	testCases := []struct {
+		sender    string
+		recipient string
+		expected  string
+	}{
+		{"", servicetypes.RequestAccName, FlowTypeServiceFeeEscrow},
+		{servicetypes.RequestAccName, "", FlowTypeServiceFee},
+		{servicetypes.RequestAccName, servicetypes.TaxAccName, FlowTypeTax},
+		{servicetypes.TaxAccName, "", FlowTypeTax},
+		{"", servicetypes.DepositAccName, FlowTypeDeposit},
+		{"", govtypes.ModuleName, FlowTypeDeposit},
+		{govtypes.ModuleName, "", FlowTypeRefund},
+		{authtypes.FeeCollectorName, "distribution", FlowTypeReward},
+		{minttypes.ModuleName, authtypes.FeeCollectorName, FlowTypeTransfer},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, coinFlowType(tc.sender, tc.recipient), "%s -> %s", tc.sender, tc.recipient)
+	}
+}
+
+func TestBurnFlowType(t *testing.T) {
+	testCases := []struct {
+		module   string
+		expected string
+	}{
+		{stakingtypes.BondedPoolName, FlowTypeSlash},
+		{stakingtypes.NotBondedPoolName, FlowTypeSlash},
+		{servicetypes.DepositAccName, FlowTypeSlash},
+		{govtypes.ModuleName, FlowTypeDepositBurn},
+		{tokentypes.ModuleName, FlowTypeBurn},
+		{servicetypes.TaxAccName, FlowTypeBurn},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, burnFlowType(tc.module), tc.module)
+	}
+}
+
+func TestCoinFlowBankKeeper(t *testing.T) {
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	bk := newCoinFlowBankKeeper(app.bankKeeper, govtypes.ModuleName)
+
+	amt := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, bk.MintCoins(ctx, minttypes.ModuleName, amt))
+	require.NoError(t, bk.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, govtypes.ModuleName, amt))
+	require.NoError(t, bk.BurnCoins(ctx, govtypes.ModuleName, amt))
+
+	// failed transfers are not tagged
+	require.Error(t, bk.BurnCoins(ctx, govtypes.ModuleName, amt))
+
+	var flows []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == EventTypeCoinFlow {
+			flows = append(flows, event)
+		}
+	}
+	require.Len(t, flows, 3)
+
+	expected := []struct {
+		flowType  string
+		sender    string
+		recipient string
+	}{
+		{FlowTypeMint, "", authtypes.NewModuleAddress(minttypes.ModuleName).String()},
+		{FlowTypeDeposit, authtypes.NewModuleAddress(minttypes.ModuleName).String(), authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+		{FlowTypeDepositBurn, authtypes.NewModuleAddress(govtypes.ModuleName).String(), ""},
+	}
+
+	for i, exp := range expected {
+		attributes := make(map[string]string)
+		for _, attr := range flows[i].Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+
+		require.Equal(t, exp.flowType, attributes[AttributeKeyFlowType])
+		require.Equal(t, govtypes.ModuleName, attributes[sdk.AttributeKeyModule])
+		require.Equal(t, exp.sender, attributes[sdk.AttributeKeySender])
+		require.Equal(t, exp.recipient, attributes[AttributeKeyRecipient])
+		require.Equal(t, amt.String(), attributes[sdk.AttributeKeyAmount])
+	}
+}
@@ -0,0 +1,259 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/irisnet/irismod/modules/coinswap"
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	coinswapquerykeeper "github.com/irisnet/irishub/modules/coinswapquery/keeper"
+	coinswapquerytypes "github.com/irisnet/irishub/modules/coinswapquery/types"
+	featuregatekeeper "github.com/irisnet/irishub/modules/featuregate/keeper"
+)
+
+// FeatureConstantProductCheck is the feature gate name enabling the rejection of
+// the coinswap messages other than liquidity removals which decrease the constant
+// product of a pool
+const FeatureConstantProductCheck = "constant-product-check"
+
+// pool reserves event type and attributes
+const (
+	EventTypePoolReserves = "pool_reserves"
+
+	AttributeKeyPool            = "pool"
+	AttributeKeyAction          = "action"
+	AttributeKeyInput           = "input"
+	AttributeKeyOutput          = "output"
+	AttributeKeyFee             = "fee"
+	AttributeKeyReservesBefore  = "reserves_before"
+	AttributeKeyReservesAfter   = "reserves_after"
+	AttributeKeyLiquidityBefore = "liquidity_before"
+	AttributeKeyLiquidityAfter  = "liquidity_after"
+)
+
+// coinswapModule wraps the coinswap module to route its messages through
+// coinswapMsgServer, to register the coinswap invariants, to serve the
+// queries of the coinswap pools and to simulate the coinswap messages
+type coinswapModule struct {
+	coinswap.AppModule
+
+	msgServer     coinswaptypes.MsgServer
+	keeper        coinswapkeeper.Keeper
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    bankkeeper.Keeper
+	queryKeeper   coinswapquerykeeper.Keeper
+}
+
+func newCoinswapModule(
+	am coinswap.AppModule, k coinswapkeeper.Keeper, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, fk featuregatekeeper.Keeper,
+) coinswapModule {
+	return coinswapModule{
+		AppModule:     am,
+		msgServer:     newCoinswapMsgServer(k, bk, fk),
+		keeper:        k,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		queryKeeper:   coinswapquerykeeper.NewKeeper(k, bk),
+	}
+}
+
+// RegisterServices registers module services.
+func (am coinswapModule) RegisterServices(cfg module.Configurator) {
+	coinswaptypes.RegisterMsgServer(cfg.MsgServer(), am.msgServer)
+	coinswaptypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	coinswapquerytypes.RegisterQueryServer(cfg.QueryServer(), am.queryKeeper)
+}
+
+// Route returns the message routing key for the coinswap module.
+func (am coinswapModule) Route() sdk.Route {
+	return sdk.NewRoute(coinswaptypes.RouterKey, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *coinswaptypes.MsgAddLiquidity:
+			res, err := am.msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *coinswaptypes.MsgSwapOrder:
+			res, err := am.msgServer.SwapCoin(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *coinswaptypes.MsgRemoveLiquidity:
+			res, err := am.msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", coinswaptypes.ModuleName, msg)
+		}
+	})
+}
+
+// coinswapMsgServer wraps the coinswap msg server and emits a pool_reserves
+// event for every pool a message changes, with the coins it added to and
+// withdrew from the pool, the swap fee and the pool reserves and liquidity
+// before and after, so that indexers need not replay the keeper math. Once
+// the feature is active, it also rejects the swaps which would decrease the
+// constant product of a pool.
+type coinswapMsgServer struct {
+	coinswaptypes.MsgServer
+
+	keeper     coinswapkeeper.Keeper
+	bankKeeper bankkeeper.Keeper
+	fk         featuregatekeeper.Keeper
+}
+
+func newCoinswapMsgServer(k coinswapkeeper.Keeper, bk bankkeeper.Keeper, fk featuregatekeeper.Keeper) coinswapMsgServer {
+	return coinswapMsgServer{
+		MsgServer:  coinswapkeeper.NewMsgServerImpl(k),
+		keeper:     k,
+		bankKeeper: bk,
+		fk:         fk,
+	}
+}
+
+// AddLiquidity implements coinswaptypes.MsgServer
+func (s coinswapMsgServer) AddLiquidity(
+	goCtx context.Context, msg *coinswaptypes.MsgAddLiquidity,
+) (res *coinswaptypes.MsgAddLiquidityResponse, err error) {
+	err = s.trackPools(goCtx, coinswaptypes.EventTypeAddLiquidity, func() error {
+		res, err = s.MsgServer.AddLiquidity(goCtx, msg)
+		return err
+	}, msg.MaxToken.Denom)
+	return res, err
+}
+
+// RemoveLiquidity implements coinswaptypes.MsgServer
+func (s coinswapMsgServer) RemoveLiquidity(
+	goCtx context.Context, msg *coinswaptypes.MsgRemoveLiquidity,
+) (res *coinswaptypes.MsgRemoveLiquidityResponse, err error) {
+	err = s.trackPools(goCtx, coinswaptypes.EventTypeRemoveLiquidity, func() error {
+		res, err = s.MsgServer.RemoveLiquidity(goCtx, msg)
+		return err
+	}, msg.WithdrawLiquidity.Denom)
+	return res, err
+}
+
+// SwapCoin implements coinswaptypes.MsgServer
+func (s coinswapMsgServer) SwapCoin(
+	goCtx context.Context, msg *coinswaptypes.MsgSwapOrder,
+) (res *coinswaptypes.MsgSwapCoinResponse, err error) {
+	err = s.trackPools(goCtx, coinswaptypes.EventTypeSwap, func() error {
+		res, err = s.MsgServer.SwapCoin(goCtx, msg)
+		return err
+	}, msg.Input.Coin.Denom, msg.Output.Coin.Denom)
+	return res, err
+}
+
+// poolState is the state of a pool at a point in time
+type poolState struct {
+	reserves  sdk.Coins
+	liquidity sdk.Int
+}
+
+// trackPools executes a coinswap message and emits the pool_reserves events
+// of the pools of the given denoms once it succeeds. Once the feature is
+// active, only the removal of liquidity may decrease the constant product of
+// a pool.
+func (s coinswapMsgServer) trackPools(goCtx context.Context, action string, exec func() error, denoms ...string) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pools := s.poolDenoms(ctx, denoms...)
+	before := make([]poolState, len(pools))
+	for i, pool := range pools {
+		before[i] = s.poolState(ctx, pool)
+	}
+
+	if err := exec(); err != nil {
+		return err
+	}
+
+	standardDenom := s.keeper.GetStandardDenom(ctx)
+	fee := s.keeper.GetParams(ctx).Fee
+	checkProduct := action != coinswaptypes.EventTypeRemoveLiquidity && s.fk.IsFeatureActive(ctx, FeatureConstantProductCheck)
+	for i, pool := range pools {
+		after := s.poolState(ctx, pool)
+
+		productBefore := constantProduct(before[i].reserves, standardDenom, pool)
+		productAfter := constantProduct(after.reserves, standardDenom, pool)
+		if checkProduct && productAfter.LT(productBefore) {
+			return sdkerrors.Wrapf(
+				coinswaptypes.ErrConstraintNotMet, "the constant product of pool %s decreased from %s to %s",
+				pool, productBefore, productAfter,
+			)
+		}
+		input, output := poolFlows(before[i].reserves, after.reserves)
+
+		// the fee is the share of the swap input kept by the pool
+		fees := sdk.NewCoins()
+		if action == coinswaptypes.EventTypeSwap {
+			for _, coin := range input {
+				fees = fees.Add(sdk.NewCoin(coin.Denom, fee.MulInt(coin.Amount).TruncateInt()))
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypePoolReserves,
+				sdk.NewAttribute(AttributeKeyPool, pool),
+				sdk.NewAttribute(AttributeKeyAction, action),
+				sdk.NewAttribute(AttributeKeyInput, input.String()),
+				sdk.NewAttribute(AttributeKeyOutput, output.String()),
+				sdk.NewAttribute(AttributeKeyFee, fees.String()),
+				sdk.NewAttribute(AttributeKeyReservesBefore, before[i].reserves.String()),
+				sdk.NewAttribute(AttributeKeyReservesAfter, after.reserves.String()),
+				sdk.NewAttribute(AttributeKeyLiquidityBefore, before[i].liquidity.String()),
+				sdk.NewAttribute(AttributeKeyLiquidityAfter, after.liquidity.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// poolDenoms returns the liquidity denoms of the pools of the given denoms,
+// which are either token denoms, liquidity denoms or the standard denom
+func (s coinswapMsgServer) poolDenoms(ctx sdk.Context, denoms ...string) []string {
+	standardDenom := s.keeper.GetStandardDenom(ctx)
+
+	var pools []string
+	for _, denom := range denoms {
+		switch {
+		case denom == standardDenom:
+			continue
+		case strings.HasPrefix(denom, coinswaptypes.FormatUniABSPrefix):
+			pools = append(pools, denom)
+		default:
+			pools = append(pools, coinswaptypes.FormatUniABSPrefix+denom)
+		}
+	}
+	return pools
+}
+
+func (s coinswapMsgServer) poolState(ctx sdk.Context, pool string) poolState {
+	return poolState{
+		reserves:  s.keeper.GetReservePool(ctx, pool),
+		liquidity: s.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(pool),
+	}
+}
+
+// poolFlows returns the coins added to and withdrawn from a pool given its reserves before and after
+func poolFlows(before, after sdk.Coins) (input, output sdk.Coins) {
+	input, output = sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range before.Add(after...) {
+		diff := after.AmountOf(coin.Denom).Sub(before.AmountOf(coin.Denom))
+		switch {
+		case diff.IsPositive():
+			input = input.Add(sdk.NewCoin(coin.Denom, diff))
+		case diff.IsNegative():
+			output = output.Add(sdk.NewCoin(coin.Denom, diff.Neg()))
+		}
+	}
+	return input, output
+}
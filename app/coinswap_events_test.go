@@ -0,0 +1,142 @@
+package app
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/irisnet/irismod/modules/coinswap"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestPoolFlows(t *testing.T) {
+	before := sdk.NewCoins(sdk.NewInt64Coin("btc", 1000), sdk.NewInt64Coin("uiris", 1000))
+	after := sdk.NewCoins(sdk.NewInt64Coin("btc", 910), sdk.NewInt64Coin("uiris", 1100))
+
+	input, output := poolFlows(before, after)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 100)), input)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("btc", 90)), output)
+
+	input, output = poolFlows(nil, before)
+	require.Equal(t, before, input)
+	require.Empty(t, output)
+}
+
+// setupCoinswapTest returns an app and a context with an account funded with the standard denom and btc
+func setupCoinswapTest(t *testing.T) (*IrisApp, sdk.Context, sdk.AccAddress) {
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	standardDenom := app.coinswapKeeper.GetStandardDenom(ctx)
+
+	sender := sdk.AccAddress([]byte("coinswap-test-sender"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(standardDenom, 10000), sdk.NewInt64Coin("btc", 10000))
+	require.NoError(t, app.bankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, app.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, funds))
+
+	return app, ctx, sender
+}
+
+// addCoinswapLiquidity adds liquidity to the btc pool through the given msg server
+func addCoinswapLiquidity(t *testing.T, msgServer coinswaptypes.MsgServer, ctx sdk.Context, sender sdk.AccAddress) {
+	_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &coinswaptypes.MsgAddLiquidity{
+		MaxToken:         sdk.NewInt64Coin("btc", 1000),
+		ExactStandardAmt: sdk.NewInt(1000),
+		MinLiquidity:     sdk.NewInt(1),
+		Deadline:         ctx.BlockTime().Add(time.Hour).Unix(),
+		Sender:           sender.String(),
+	})
+	require.NoError(t, err)
+}
+
+func TestCoinswapMsgServerEvents(t *testing.T) {
+	app, ctx, sender := setupCoinswapTest(t)
+	standardDenom := app.coinswapKeeper.GetStandardDenom(ctx)
+
+	msgServer := newCoinswapMsgServer(app.coinswapKeeper, app.bankKeeper, app.featureGateKeeper)
+	addCoinswapLiquidity(t, msgServer, ctx, sender)
+
+	_, err := msgServer.SwapCoin(sdk.WrapSDKContext(ctx), &coinswaptypes.MsgSwapOrder{
+		Input:    coinswaptypes.Input{Address: sender.String(), Coin: sdk.NewInt64Coin(standardDenom, 100)},
+		Output:   coinswaptypes.Output{Address: sender.String(), Coin: sdk.NewInt64Coin("btc", 1)},
+		Deadline: ctx.BlockTime().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+
+	var events []map[string]string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != EventTypePoolReserves {
+			continue
+		}
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+		events = append(events, attributes)
+	}
+	require.Len(t, events, 2)
+
+	pool := coinswaptypes.FormatUniABSPrefix + "btc"
+	reserves := sdk.NewCoins(sdk.NewInt64Coin("btc", 1000), sdk.NewInt64Coin(standardDenom, 1000))
+
+	require.Equal(t, pool, events[0][AttributeKeyPool])
+	require.Equal(t, coinswaptypes.EventTypeAddLiquidity, events[0][AttributeKeyAction])
+	require.Equal(t, reserves.String(), events[0][AttributeKeyInput])
+	require.Equal(t, "", events[0][AttributeKeyReservesBefore])
+	require.Equal(t, reserves.String(), events[0][AttributeKeyReservesAfter])
+	require.Equal(t, "0", events[0][AttributeKeyLiquidityBefore])
+	require.Equal(t, "1000", events[0][AttributeKeyLiquidityAfter])
+
+	// 100 * 0.997 * 1000 / (1000 + 100 * 0.997) = 90.66...
+	require.Equal(t, coinswaptypes.EventTypeSwap, events[1][AttributeKeyAction])
+	require.Equal(t, sdk.NewInt64Coin(standardDenom, 100).String(), events[1][AttributeKeyInput])
+	require.Equal(t, sdk.NewInt64Coin("btc", 90).String(), events[1][AttributeKeyOutput])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(standardDenom, app.coinswapKeeper.GetParams(ctx).Fee.MulInt64(100).TruncateInt())).String(), events[1][AttributeKeyFee])
+	require.Equal(t, reserves.String(), events[1][AttributeKeyReservesBefore])
+	require.Equal(t, "1000", events[1][AttributeKeyLiquidityAfter])
+}
+
+func TestCoinswapSimulationOperations(t *testing.T) {
+	app, ctx, _ := setupCoinswapTest(t)
+	standardDenom := app.coinswapKeeper.GetStandardDenom(ctx)
+
+	// signatures at genesis height don't carry account numbers
+	header := tmproto.Header{Height: 1, Time: ctx.BlockTime()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = app.BaseApp.NewContext(false, header)
+
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 1)
+	funds := sdk.NewCoins(sdk.NewInt64Coin(standardDenom, 10000), sdk.NewInt64Coin("btc", 10000))
+	require.NoError(t, app.bankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, app.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accs[0].Address, funds))
+
+	am := newCoinswapModule(
+		coinswap.NewAppModule(app.appCodec, app.coinswapKeeper, app.accountKeeper, app.bankKeeper),
+		app.coinswapKeeper, app.accountKeeper, app.bankKeeper, app.featureGateKeeper,
+	)
+	for _, op := range []simtypes.Operation{am.simulateAddLiquidity, am.simulateSwapOrder, am.simulateRemoveLiquidity} {
+		opMsg, _, err := op(r, app.BaseApp, ctx, accs, ctx.ChainID())
+		require.NoError(t, err)
+		require.True(t, opMsg.OK, opMsg.Comment)
+	}
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// RegisterInvariants registers the coinswap invariants
+func (am coinswapModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	ir.RegisterRoute(coinswaptypes.ModuleName, "module-account", CoinswapModuleAccountInvariant(am.bankKeeper))
+	ir.RegisterRoute(coinswaptypes.ModuleName, "pool-reserves", CoinswapPoolReservesInvariant(am.keeper, am.bankKeeper))
+}
+
+// CoinswapModuleAccountInvariant checks that the coinswap module account holds
+// no coins: the reserves are kept by an account per pool, and the liquidity
+// tokens only pass through the module account when minted or burned
+func CoinswapModuleAccountInvariant(bk bankkeeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balance := bk.GetAllBalances(ctx, authtypes.NewModuleAddress(coinswaptypes.ModuleName))
+
+		return sdk.FormatInvariant(
+			coinswaptypes.ModuleName, "module-account",
+			fmt.Sprintf("\tcoinswap module account balance: %s\n", balance),
+		), !balance.Empty()
+	}
+}
+
+// CoinswapPoolReservesInvariant checks that every pool with a liquidity token
+// supply holds reserves of both the standard denom and its token, so that its
+// constant product is positive
+func CoinswapPoolReservesInvariant(k coinswapkeeper.Keeper, bk bankkeeper.Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		standardDenom := k.GetStandardDenom(ctx)
+
+		var msg string
+		count := 0
+		for _, liquidity := range bk.GetSupply(ctx).GetTotal() {
+			if !strings.HasPrefix(liquidity.Denom, coinswaptypes.FormatUniABSPrefix) || !liquidity.IsPositive() {
+				continue
+			}
+
+			denom := strings.TrimPrefix(liquidity.Denom, coinswaptypes.FormatUniABSPrefix)
+			reserves := k.GetReservePool(ctx, liquidity.Denom)
+			if reserves.AmountOf(standardDenom).IsPositive() && reserves.AmountOf(denom).IsPositive() {
+				continue
+			}
+
+			count++
+			msg += fmt.Sprintf("\tpool %s with liquidity %s has reserves %s\n", liquidity.Denom, liquidity.Amount, reserves)
+		}
+
+		return sdk.FormatInvariant(
+			coinswaptypes.ModuleName, "pool-reserves",
+			fmt.Sprintf("%d pools without reserves found\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// constantProduct returns the product of the reserves of a pool
+func constantProduct(reserves sdk.Coins, standardDenom, pool string) sdk.Int {
+	denom := strings.TrimPrefix(pool, coinswaptypes.FormatUniABSPrefix)
+	return reserves.AmountOf(standardDenom).Mul(reserves.AmountOf(denom))
+}
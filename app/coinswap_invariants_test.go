@@ -0,0 +1,44 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+func TestCoinswapInvariants(t *testing.T) {
+	app, ctx, sender := setupCoinswapTest(t)
+	standardDenom := app.coinswapKeeper.GetStandardDenom(ctx)
+
+	addCoinswapLiquidity(t, newCoinswapMsgServer(app.coinswapKeeper, app.bankKeeper, app.featureGateKeeper), ctx, sender)
+
+	moduleAccountInvariant := CoinswapModuleAccountInvariant(app.bankKeeper)
+	poolReservesInvariant := CoinswapPoolReservesInvariant(app.coinswapKeeper, app.bankKeeper)
+
+	_, broken := moduleAccountInvariant(ctx)
+	require.False(t, broken)
+	_, broken = poolReservesInvariant(ctx)
+	require.False(t, broken)
+
+	// drain the standard denom reserve of the pool
+	pool := coinswaptypes.GetReservePoolAddr(coinswaptypes.FormatUniABSPrefix + "btc")
+	require.NoError(t, app.bankKeeper.SendCoins(ctx, pool, sender, sdk.NewCoins(sdk.NewInt64Coin(standardDenom, 1000))))
+	_, broken = poolReservesInvariant(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, app.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, sender, coinswaptypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(standardDenom, 1)),
+	))
+	_, broken = moduleAccountInvariant(ctx)
+	require.True(t, broken)
+}
+
+func TestConstantProduct(t *testing.T) {
+	reserves := sdk.NewCoins(sdk.NewInt64Coin("btc", 20), sdk.NewInt64Coin("uiris", 30))
+	require.Equal(t, sdk.NewInt(600), constantProduct(reserves, "uiris", coinswaptypes.FormatUniABSPrefix+"btc"))
+	require.Equal(t, sdk.ZeroInt(), constantProduct(nil, "uiris", coinswaptypes.FormatUniABSPrefix+"btc"))
+}
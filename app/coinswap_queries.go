@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/coinswap"
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+
+	coinswapquerycli "github.com/irisnet/irishub/modules/coinswapquery/client/cli"
+	coinswapquerykeeper "github.com/irisnet/irishub/modules/coinswapquery/keeper"
+	coinswapquerytypes "github.com/irisnet/irishub/modules/coinswapquery/types"
+)
+
+// coinswapModuleBasic extends the coinswap module basic with the queries of
+// the coinswap pools and of the swap estimates
+type coinswapModuleBasic struct {
+	coinswap.AppModuleBasic
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the coinswap module.
+func (b coinswapModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	b.AppModuleBasic.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	_ = coinswapquerytypes.RegisterQueryHandlerClient(context.Background(), mux, coinswapquerytypes.NewQueryClient(clientCtx))
+}
+
+// GetQueryCmd returns the root query command for the coinswap module.
+func (coinswapModuleBasic) GetQueryCmd() *cobra.Command {
+	return coinswapquerycli.GetQueryCmd()
+}
+
+// LegacyQuerierHandler returns the coinswap module sdk.Querier, which also
+// serves the queries of the coinswap pools and of the swap estimates.
+func (am coinswapModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	querier := coinswapkeeper.NewQuerier(am.keeper, legacyQuerierCdc)
+	poolQuerier := coinswapquerykeeper.NewQuerier(am.queryKeeper, legacyQuerierCdc)
+
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case coinswapquerytypes.QueryPool, coinswapquerytypes.QueryPools, coinswapquerytypes.QueryEstimateSwap:
+			return poolQuerier(ctx, path, req)
+		default:
+			return querier(ctx, path, req)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package app
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// coinswap simulation operation weights
+const (
+	OpWeightMsgAddLiquidity    = "op_weight_msg_add_liquidity"
+	OpWeightMsgSwapOrder       = "op_weight_msg_swap_order"
+	OpWeightMsgRemoveLiquidity = "op_weight_msg_remove_liquidity"
+)
+
+// WeightedOperations returns the coinswap operations with their respective
+// weights, which the coinswap module does not provide, so that the simulations
+// exercise the pools and their invariants.
+func (am coinswapModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	var weightAdd, weightSwap, weightRemove int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OpWeightMsgAddLiquidity, &weightAdd, nil,
+		func(_ *rand.Rand) { weightAdd = 100 },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OpWeightMsgSwapOrder, &weightSwap, nil,
+		func(_ *rand.Rand) { weightSwap = 100 },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OpWeightMsgRemoveLiquidity, &weightRemove, nil,
+		func(_ *rand.Rand) { weightRemove = 50 },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightAdd, am.simulateAddLiquidity),
+		simulation.NewWeightedOperation(weightSwap, am.simulateSwapOrder),
+		simulation.NewWeightedOperation(weightRemove, am.simulateRemoveLiquidity),
+	}
+}
+
+// simulateAddLiquidity adds liquidity of a random account to the pool of one
+// of its tokens, creating the pool if needed
+func (am coinswapModule) simulateAddLiquidity(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	simAccount, _ := simtypes.RandomAcc(r, accs)
+	spendable := am.bankKeeper.SpendableCoins(ctx, simAccount.Address)
+	standardDenom := am.keeper.GetStandardDenom(ctx)
+
+	var tokens sdk.Coins
+	for _, coin := range spendable {
+		if coin.Denom != standardDenom && !strings.HasPrefix(coin.Denom, coinswaptypes.FormatUniABSPrefix) {
+			tokens = append(tokens, coin)
+		}
+	}
+	standardAmt := spendable.AmountOf(standardDenom).QuoRaw(4)
+	if len(tokens) == 0 || !standardAmt.IsPositive() {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgAddLiquidity, "no token to add"), nil, nil
+	}
+	token := tokens[r.Intn(len(tokens))]
+
+	uniDenom, err := coinswaptypes.GetUniDenomFromDenom(token.Denom)
+	if err != nil {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgAddLiquidity, "invalid token"), nil, nil
+	}
+	reserves := am.keeper.GetReservePool(ctx, uniDenom)
+	exactStandardAmt, err := simtypes.RandPositiveInt(r, standardAmt)
+	if err != nil {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgAddLiquidity, "no standard amount"), nil, nil
+	}
+
+	maxToken := token
+	if am.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(uniDenom).IsPositive() {
+		// the deposit of an existing pool follows its price
+		deposit := reserves.AmountOf(token.Denom).Mul(exactStandardAmt).Quo(reserves.AmountOf(standardDenom)).AddRaw(1)
+		if deposit.GT(token.Amount) {
+			return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgAddLiquidity, "insufficient tokens"), nil, nil
+		}
+	} else if maxToken.Amount, err = simtypes.RandPositiveInt(r, token.Amount); err != nil {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgAddLiquidity, "no token amount"), nil, nil
+	}
+
+	msg := coinswaptypes.NewMsgAddLiquidity(
+		maxToken, exactStandardAmt, sdk.OneInt(), ctx.BlockTime().Add(time.Hour).Unix(), simAccount.Address.String(),
+	)
+	return am.deliverSimulatedMsg(r, app, ctx, simAccount, chainID, msg)
+}
+
+// simulateSwapOrder sells a random amount of the standard coins or of the
+// tokens of a random account for the other side of their pool
+func (am coinswapModule) simulateSwapOrder(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	simAccount, _ := simtypes.RandomAcc(r, accs)
+	spendable := am.bankKeeper.SpendableCoins(ctx, simAccount.Address)
+	standardDenom := am.keeper.GetStandardDenom(ctx)
+
+	var uniDenoms []string
+	for _, coin := range am.bankKeeper.GetSupply(ctx).GetTotal() {
+		if strings.HasPrefix(coin.Denom, coinswaptypes.FormatUniABSPrefix) && coin.IsPositive() {
+			uniDenoms = append(uniDenoms, coin.Denom)
+		}
+	}
+	if len(uniDenoms) == 0 {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgSwapOrder, "no pool"), nil, nil
+	}
+	uniDenom := uniDenoms[r.Intn(len(uniDenoms))]
+	tokenDenom := strings.TrimPrefix(uniDenom, coinswaptypes.FormatUniABSPrefix)
+
+	inputDenom, outputDenom := standardDenom, tokenDenom
+	if r.Intn(2) == 0 {
+		inputDenom, outputDenom = tokenDenom, standardDenom
+	}
+
+	// sell at most a tenth of the reserve
+	reserves := am.keeper.GetReservePool(ctx, uniDenom)
+	maxInput := sdk.MinInt(spendable.AmountOf(inputDenom), reserves.AmountOf(inputDenom).QuoRaw(10))
+	if !maxInput.IsPositive() {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgSwapOrder, "insufficient funds"), nil, nil
+	}
+	inputAmt, err := simtypes.RandPositiveInt(r, maxInput)
+	if err != nil {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgSwapOrder, "no input amount"), nil, nil
+	}
+
+	outputAmt := coinswapkeeper.GetInputPrice(
+		inputAmt, reserves.AmountOf(inputDenom), reserves.AmountOf(outputDenom), am.keeper.GetParams(ctx).Fee,
+	)
+	if !outputAmt.IsPositive() {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgSwapOrder, "output too small"), nil, nil
+	}
+
+	msg := coinswaptypes.NewMsgSwapOrder(
+		coinswaptypes.Input{Address: simAccount.Address.String(), Coin: sdk.NewCoin(inputDenom, inputAmt)},
+		coinswaptypes.Output{Address: simAccount.Address.String(), Coin: sdk.NewCoin(outputDenom, outputAmt)},
+		ctx.BlockTime().Add(time.Hour).Unix(), false,
+	)
+	return am.deliverSimulatedMsg(r, app, ctx, simAccount, chainID, msg)
+}
+
+// simulateRemoveLiquidity withdraws a random share of the liquidity of a
+// random account from its pool
+func (am coinswapModule) simulateRemoveLiquidity(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	simAccount, _ := simtypes.RandomAcc(r, accs)
+
+	var liquidity sdk.Coins
+	for _, coin := range am.bankKeeper.SpendableCoins(ctx, simAccount.Address) {
+		if strings.HasPrefix(coin.Denom, coinswaptypes.FormatUniABSPrefix) {
+			liquidity = append(liquidity, coin)
+		}
+	}
+	if len(liquidity) == 0 {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgRemoveLiquidity, "no liquidity"), nil, nil
+	}
+	coin := liquidity[r.Intn(len(liquidity))]
+
+	withdrawAmt, err := simtypes.RandPositiveInt(r, coin.Amount)
+	if err != nil {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, coinswaptypes.TypeMsgRemoveLiquidity, "no withdrawal amount"), nil, nil
+	}
+
+	msg := coinswaptypes.NewMsgRemoveLiquidity(
+		sdk.ZeroInt(), sdk.NewCoin(coin.Denom, withdrawAmt), sdk.ZeroInt(),
+		ctx.BlockTime().Add(time.Hour).Unix(), simAccount.Address.String(),
+	)
+	return am.deliverSimulatedMsg(r, app, ctx, simAccount, chainID, msg)
+}
+
+// deliverSimulatedMsg signs and delivers a coinswap message of a simulated
+// account without fees
+func (am coinswapModule) deliverSimulatedMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, simAccount simtypes.Account, chainID string, msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := am.accountKeeper.GetAccount(ctx, simAccount.Address)
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		sdk.NewCoins(),
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	if _, _, err = app.Deliver(txGen.TxEncoder(), tx); err != nil {
+		return simtypes.NoOpMsg(coinswaptypes.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+	return simtypes.NewOperationMsg(msg, true, fmt.Sprintf("simulate %s", msg.Type())), nil, nil
+}
@@ -5,13 +5,24 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
 	servicetypes "github.com/irisnet/irismod/modules/service/types"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
 	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	blacklistkeeper "github.com/irisnet/irishub/modules/blacklist/keeper"
+	dustsweepkeeper "github.com/irisnet/irishub/modules/dustsweep/keeper"
+	featuregatekeeper "github.com/irisnet/irishub/modules/featuregate/keeper"
+	poolwhitelistkeeper "github.com/irisnet/irishub/modules/poolwhitelist/keeper"
+	poolwhitelisttypes "github.com/irisnet/irishub/modules/poolwhitelist/types"
 )
 
 // ValidateTokenDecorator is responsible for restricting the token participation of the swap prefix
@@ -55,12 +66,19 @@ func (vtd ValidateTokenDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 	return next(ctx, tx, simulate)
 }
 
+// FeatureRepeatedServiceInvocation is the feature gate name enabling repeated MsgCallService
+const FeatureRepeatedServiceInvocation = "repeated-service-invocation"
+
 // ValidateServiceDecorator is responsible for checking the permission to execute MsgCallService
-type ValidateServiceDecorator struct{}
+type ValidateServiceDecorator struct {
+	fk featuregatekeeper.Keeper
+}
 
 // NewValidateServiceDecorator returns an instance of ServiceAuthDecorator
-func NewValidateServiceDecorator() ValidateServiceDecorator {
-	return ValidateServiceDecorator{}
+func NewValidateServiceDecorator(fk featuregatekeeper.Keeper) ValidateServiceDecorator {
+	return ValidateServiceDecorator{
+		fk: fk,
+	}
 }
 
 // AnteHandle checks the transaction
@@ -68,7 +86,7 @@ func (vsd ValidateServiceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	for _, msg := range tx.GetMsgs() {
 		switch msg := msg.(type) {
 		case *servicetypes.MsgCallService:
-			if msg.Repeated {
+			if msg.Repeated && !vsd.fk.IsFeatureActive(ctx, FeatureRepeatedServiceInvocation) {
 				return ctx, sdkerrors.Wrap(
 					sdkerrors.ErrInvalidRequest, "currently does not support to create repeatable service invocation")
 			}
@@ -77,6 +95,282 @@ func (vsd ValidateServiceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// ValidateSendEnabledDecorator applies the per-denom SendEnabled parameters of the bank
+// module to messages of other modules which move coins between accounts
+type ValidateSendEnabledDecorator struct {
+	bk bankkeeper.Keeper
+	tk tokenkeeper.Keeper
+}
+
+// NewValidateSendEnabledDecorator returns an instance of ValidateSendEnabledDecorator
+func NewValidateSendEnabledDecorator(bk bankkeeper.Keeper, tk tokenkeeper.Keeper) ValidateSendEnabledDecorator {
+	return ValidateSendEnabledDecorator{
+		bk: bk,
+		tk: tk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vsed ValidateSendEnabledDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		var coins []sdk.Coin
+		switch msg := msg.(type) {
+		case *ibctransfertypes.MsgTransfer:
+			coins = []sdk.Coin{msg.Token}
+		case *htlctypes.MsgCreateHTLC:
+			coins = msg.Amount
+		case *coinswaptypes.MsgSwapOrder:
+			coins = []sdk.Coin{msg.Input.Coin, msg.Output.Coin}
+		case *coinswaptypes.MsgAddLiquidity:
+			coins = []sdk.Coin{msg.MaxToken}
+		case *coinswaptypes.MsgRemoveLiquidity:
+			coins = []sdk.Coin{msg.WithdrawLiquidity}
+			// the pool pays out its token along with the standard denom
+			if denom, err := coinswaptypes.GetCoinDenomFromUniDenom(msg.WithdrawLiquidity.Denom); err == nil {
+				coins = append(coins, sdk.Coin{Denom: denom, Amount: msg.MinToken})
+			}
+		case *servicetypes.MsgCallService:
+			coins = msg.ServiceFeeCap
+		case *servicetypes.MsgUpdateRequestContext:
+			coins = msg.ServiceFeeCap
+		case *tokentypes.MsgMintToken:
+			coins = vsed.tokenCoins(ctx, msg.Symbol)
+		case *tokentypes.MsgTransferTokenOwner:
+			coins = vsed.tokenCoins(ctx, msg.Symbol)
+		default:
+			continue
+		}
+		if err := vsed.bk.SendEnabledCoins(ctx, coins...); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// tokenCoins returns a zero coin of the min unit of the given token, which is
+// enough to check its send-enabled state. Unknown tokens are left to the token
+// module to reject.
+func (vsed ValidateSendEnabledDecorator) tokenCoins(ctx sdk.Context, symbol string) []sdk.Coin {
+	token, err := vsed.tk.GetToken(ctx, symbol)
+	if err != nil {
+		return nil
+	}
+	return []sdk.Coin{sdk.NewCoin(token.GetMinUnit(), sdk.ZeroInt())}
+}
+
+// FeatureTextValidation is the feature gate name enabling the validation of the user-supplied texts
+const FeatureTextValidation = "text-validation"
+
+// ValidateTextDecorator is responsible for rejecting the messages whose user-supplied
+// texts stored on chain break the rules of ValidateText
+type ValidateTextDecorator struct {
+	fk featuregatekeeper.Keeper
+}
+
+// NewValidateTextDecorator returns an instance of ValidateTextDecorator
+func NewValidateTextDecorator(fk featuregatekeeper.Keeper) ValidateTextDecorator {
+	return ValidateTextDecorator{
+		fk: fk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vtd ValidateTextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !vtd.fk.IsFeatureActive(ctx, FeatureTextValidation) {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		for _, field := range msgTextFields(msg) {
+			if err := ValidateText(field.name, field.text, field.multiline); err != nil {
+				return ctx, err
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ValidateBlacklistDecorator is responsible for rejecting the denoms blacklisted by
+// governance as tx fees and as the token side of coinswap liquidity
+type ValidateBlacklistDecorator struct {
+	bk blacklistkeeper.Keeper
+}
+
+// NewValidateBlacklistDecorator returns an instance of ValidateBlacklistDecorator
+func NewValidateBlacklistDecorator(bk blacklistkeeper.Keeper) ValidateBlacklistDecorator {
+	return ValidateBlacklistDecorator{
+		bk: bk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vbd ValidateBlacklistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if err := vbd.bk.ValidateCoins(ctx, feeTx.GetFee()...); err != nil {
+			return ctx, sdkerrors.Wrap(err, "can't pay tx fees")
+		}
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *coinswaptypes.MsgAddLiquidity:
+			if err := vbd.bk.ValidateCoins(ctx, msg.MaxToken); err != nil {
+				return ctx, sdkerrors.Wrap(err, "can't add coinswap liquidity")
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// DustSweepActivityDecorator is responsible for recording the transactions signed
+// by the accounts opted in to the sweep of their dust, which postpone the sweep
+type DustSweepActivityDecorator struct {
+	dsk dustsweepkeeper.Keeper
+}
+
+// NewDustSweepActivityDecorator returns an instance of DustSweepActivityDecorator
+func NewDustSweepActivityDecorator(dsk dustsweepkeeper.Keeper) DustSweepActivityDecorator {
+	return DustSweepActivityDecorator{
+		dsk: dsk,
+	}
+}
+
+// AnteHandle records the activity of the signers of the transaction
+func (dsad DustSweepActivityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			dsad.dsk.RecordActivity(ctx, signer)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ValidatePoolWhitelistDecorator is responsible for restricting the creation of
+// coinswap pools to the denoms whitelisted by governance when gated
+type ValidatePoolWhitelistDecorator struct {
+	pwk poolwhitelistkeeper.Keeper
+	bk  bankkeeper.Keeper
+}
+
+// NewValidatePoolWhitelistDecorator returns an instance of ValidatePoolWhitelistDecorator
+func NewValidatePoolWhitelistDecorator(pwk poolwhitelistkeeper.Keeper, bk bankkeeper.Keeper) ValidatePoolWhitelistDecorator {
+	return ValidatePoolWhitelistDecorator{
+		pwk: pwk,
+		bk:  bk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vpwd ValidatePoolWhitelistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msg, ok := msg.(*coinswaptypes.MsgAddLiquidity)
+		if !ok || vpwd.pwk.IsPoolCreationAllowed(ctx, msg.MaxToken.Denom) {
+			continue
+		}
+
+		// the coinswap keeper creates the pool of a denom when its liquidity supply is zero,
+		// whatever the balances of the reserve pool account
+		uniDenom, err := coinswaptypes.GetUniDenomFromDenom(msg.MaxToken.Denom)
+		if err != nil {
+			return ctx, err
+		}
+		if vpwd.bk.GetSupply(ctx).GetTotal().AmountOf(uniDenom).IsZero() {
+			return ctx, sdkerrors.Wrapf(
+				poolwhitelisttypes.ErrPoolNotAllowed, "denom %s is not whitelisted for new coinswap pools", msg.MaxToken.Denom)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ValidateParamBoundsDecorator is responsible for rejecting parameter change proposals
+// which set economic parameters out of their allowed ranges
+type ValidateParamBoundsDecorator struct{}
+
+// NewValidateParamBoundsDecorator returns an instance of ValidateParamBoundsDecorator
+func NewValidateParamBoundsDecorator() ValidateParamBoundsDecorator {
+	return ValidateParamBoundsDecorator{}
+}
+
+// AnteHandle checks the transaction
+func (vpbd ValidateParamBoundsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *govtypes.MsgSubmitProposal:
+			content, ok := msg.GetContent().(*proposal.ParameterChangeProposal)
+			if !ok {
+				continue
+			}
+			for _, change := range content.Changes {
+				if err := ValidateParamBounds(change); err != nil {
+					return ctx, err
+				}
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ValidateParamChangesDecorator is responsible for rejecting parameter change proposals
+// whose changes do not fit the param sets of their subspaces
+type ValidateParamChangesDecorator struct {
+	pk paramskeeper.Keeper
+}
+
+// NewValidateParamChangesDecorator returns an instance of ValidateParamChangesDecorator
+func NewValidateParamChangesDecorator(pk paramskeeper.Keeper) ValidateParamChangesDecorator {
+	return ValidateParamChangesDecorator{
+		pk: pk,
+	}
+}
+
+// AnteHandle checks the transaction
+func (vpcd ValidateParamChangesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *govtypes.MsgSubmitProposal:
+			content, ok := msg.GetContent().(*proposal.ParameterChangeProposal)
+			if !ok {
+				continue
+			}
+			if err := ValidateParamChanges(ctx, vpcd.pk, content.Changes); err != nil {
+				return ctx, err
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ValidateUpgradePlanDecorator is responsible for checking the export settings carried
+// by software upgrade proposals
+type ValidateUpgradePlanDecorator struct{}
+
+// NewValidateUpgradePlanDecorator returns an instance of ValidateUpgradePlanDecorator
+func NewValidateUpgradePlanDecorator() ValidateUpgradePlanDecorator {
+	return ValidateUpgradePlanDecorator{}
+}
+
+// AnteHandle checks the transaction
+func (vupd ValidateUpgradePlanDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case *govtypes.MsgSubmitProposal:
+			content, ok := msg.GetContent().(*upgradetypes.SoftwareUpgradeProposal)
+			if !ok {
+				continue
+			}
+			export, err := ParseHardForkExport(content.Plan.Info)
+			if err != nil {
+				return ctx, err
+			}
+			if export != nil && content.Plan.Height == 0 {
+				return ctx, sdkerrors.Wrap(
+					sdkerrors.ErrInvalidRequest, "export settings require a height-based upgrade plan")
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
 func containSwapCoin(coins ...sdk.Coin) bool {
 	for _, coin := range coins {
 		if strings.HasPrefix(coin.Denom, coinswaptypes.FormatUniABSPrefix) {
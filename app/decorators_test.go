@@ -0,0 +1,174 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	poolwhitelisttypes "github.com/irisnet/irishub/modules/poolwhitelist/types"
+)
+
+// msgsTx is a transaction only carrying messages, enough for the decorators
+// which only inspect the messages
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx msgsTx) ValidateBasic() error { return nil }
+
+func TestValidateSendEnabledDecorator(t *testing.T) {
+	app, ctx, sender := setupCoinswapTest(t)
+
+	owner := sdk.AccAddress([]byte("token-test-owner----"))
+	require.NoError(t, app.tokenKeeper.IssueToken(ctx, "btc", "Bitcoin", "sbtc", 6, 1000, 10000, true, owner))
+	require.NoError(t, app.tokenKeeper.IssueToken(ctx, "eth", "Ethereum", "seth", 6, 1000, 10000, true, owner))
+
+	// btc and the btc token are send-disabled, eth stays enabled
+	params := app.bankKeeper.GetParams(ctx)
+	params.SendEnabled = []*banktypes.SendEnabled{
+		banktypes.NewSendEnabled("btc", false),
+		banktypes.NewSendEnabled("sbtc", false),
+	}
+	app.bankKeeper.SetParams(ctx, params)
+
+	decorator := NewValidateSendEnabledDecorator(app.bankKeeper, app.tokenKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	deadline := ctx.BlockTime().Add(time.Hour).Unix()
+	btcUni, err := coinswaptypes.GetUniDenomFromDenom("btc")
+	require.NoError(t, err)
+	ethUni, err := coinswaptypes.GetUniDenomFromDenom("eth")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		msg     string
+		txMsg   sdk.Msg
+		expPass bool
+	}{
+		{"ibc transfer allowed", &ibctransfertypes.MsgTransfer{Token: sdk.NewInt64Coin("eth", 1)}, true},
+		{"ibc transfer blocked", &ibctransfertypes.MsgTransfer{Token: sdk.NewInt64Coin("btc", 1)}, false},
+		{"htlc allowed", &htlctypes.MsgCreateHTLC{Amount: sdk.NewCoins(sdk.NewInt64Coin("eth", 1))}, true},
+		{"htlc blocked", &htlctypes.MsgCreateHTLC{Amount: sdk.NewCoins(sdk.NewInt64Coin("btc", 1))}, false},
+		{
+			"swap allowed",
+			&coinswaptypes.MsgSwapOrder{
+				Input:  coinswaptypes.Input{Coin: sdk.NewInt64Coin("uiris", 1)},
+				Output: coinswaptypes.Output{Coin: sdk.NewInt64Coin("eth", 1)},
+			},
+			true,
+		},
+		{
+			"swap blocked output",
+			&coinswaptypes.MsgSwapOrder{
+				Input:  coinswaptypes.Input{Coin: sdk.NewInt64Coin("uiris", 1)},
+				Output: coinswaptypes.Output{Coin: sdk.NewInt64Coin("btc", 1)},
+			},
+			false,
+		},
+		{"add liquidity allowed", &coinswaptypes.MsgAddLiquidity{MaxToken: sdk.NewInt64Coin("eth", 1), Deadline: deadline}, true},
+		{"add liquidity blocked", &coinswaptypes.MsgAddLiquidity{MaxToken: sdk.NewInt64Coin("btc", 1), Deadline: deadline}, false},
+		{
+			"remove liquidity allowed",
+			&coinswaptypes.MsgRemoveLiquidity{WithdrawLiquidity: sdk.NewInt64Coin(ethUni, 1), MinToken: sdk.OneInt()},
+			true,
+		},
+		{
+			"remove liquidity blocked",
+			&coinswaptypes.MsgRemoveLiquidity{WithdrawLiquidity: sdk.NewInt64Coin(btcUni, 1), MinToken: sdk.OneInt()},
+			false,
+		},
+		{"call service allowed", &servicetypes.MsgCallService{ServiceFeeCap: sdk.NewCoins(sdk.NewInt64Coin("eth", 1))}, true},
+		{"call service blocked", &servicetypes.MsgCallService{ServiceFeeCap: sdk.NewCoins(sdk.NewInt64Coin("btc", 1))}, false},
+		{"update request context allowed", &servicetypes.MsgUpdateRequestContext{ServiceFeeCap: sdk.NewCoins(sdk.NewInt64Coin("eth", 1))}, true},
+		{"update request context blocked", &servicetypes.MsgUpdateRequestContext{ServiceFeeCap: sdk.NewCoins(sdk.NewInt64Coin("btc", 1))}, false},
+		{"mint token allowed", &tokentypes.MsgMintToken{Symbol: "eth", Amount: 1, Owner: owner.String()}, true},
+		{"mint token blocked", &tokentypes.MsgMintToken{Symbol: "btc", Amount: 1, Owner: owner.String()}, false},
+		{"mint unknown token", &tokentypes.MsgMintToken{Symbol: "abc", Amount: 1, Owner: owner.String()}, true},
+		{"transfer token owner allowed", &tokentypes.MsgTransferTokenOwner{Symbol: "eth", SrcOwner: owner.String(), DstOwner: sender.String()}, true},
+		{"transfer token owner blocked", &tokentypes.MsgTransferTokenOwner{Symbol: "btc", SrcOwner: owner.String(), DstOwner: sender.String()}, false},
+		{"other message", banktypes.NewMsgSend(sender, owner, sdk.NewCoins(sdk.NewInt64Coin("btc", 1))), true},
+	}
+
+	for _, tc := range testCases {
+		_, err := decorator.AnteHandle(ctx, msgsTx{tc.txMsg}, false, next)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestValidatePoolWhitelistDecorator(t *testing.T) {
+	app, ctx, sender := setupCoinswapTest(t)
+
+	decorator := NewValidatePoolWhitelistDecorator(app.poolWhitelistKeeper, app.bankKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	addLiquidity := msgsTx{&coinswaptypes.MsgAddLiquidity{
+		MaxToken:         sdk.NewInt64Coin("btc", 1000),
+		ExactStandardAmt: sdk.NewInt(1000),
+		MinLiquidity:     sdk.NewInt(1),
+		Deadline:         ctx.BlockTime().Add(time.Hour).Unix(),
+		Sender:           sender.String(),
+	}}
+
+	// not gated by default
+	_, err := decorator.AnteHandle(ctx, addLiquidity, false, next)
+	require.NoError(t, err)
+
+	app.poolWhitelistKeeper.SetParamSet(ctx, poolwhitelisttypes.NewParams(true, []string{"eth"}))
+	_, err = decorator.AnteHandle(ctx, addLiquidity, false, next)
+	require.ErrorIs(t, err, poolwhitelisttypes.ErrPoolNotAllowed)
+
+	// funding the reserve pool account does not make the pool exist
+	btcUni, err := coinswaptypes.GetUniDenomFromDenom("btc")
+	require.NoError(t, err)
+	dust := sdk.NewCoins(sdk.NewInt64Coin("btc", 1), sdk.NewInt64Coin(app.coinswapKeeper.GetStandardDenom(ctx), 1))
+	require.NoError(t, app.bankKeeper.SendCoins(ctx, sender, coinswaptypes.GetReservePoolAddr(btcUni), dust))
+	_, err = decorator.AnteHandle(ctx, addLiquidity, false, next)
+	require.ErrorIs(t, err, poolwhitelisttypes.ErrPoolNotAllowed)
+
+	// liquidity can still be added to the existing pools
+	app.poolWhitelistKeeper.SetParamSet(ctx, poolwhitelisttypes.NewParams(false, nil))
+	addCoinswapLiquidity(t, coinswapkeeper.NewMsgServerImpl(app.coinswapKeeper), ctx, sender)
+	app.poolWhitelistKeeper.SetParamSet(ctx, poolwhitelisttypes.NewParams(true, []string{"eth"}))
+	_, err = decorator.AnteHandle(ctx, addLiquidity, false, next)
+	require.NoError(t, err)
+
+	app.poolWhitelistKeeper.SetParamSet(ctx, poolwhitelisttypes.NewParams(true, []string{"btc"}))
+	_, err = decorator.AnteHandle(ctx, addLiquidity, false, next)
+	require.NoError(t, err)
+}
+
+func TestDustSweepActivityDecorator(t *testing.T) {
+	app, ctx, sender := setupCoinswapTest(t)
+
+	decorator := NewDustSweepActivityDecorator(app.dustSweepKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	send := msgsTx{banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))}
+
+	// the activity of the accounts not opted in is not recorded
+	_, err := decorator.AnteHandle(ctx, send, false, next)
+	require.NoError(t, err)
+	_, found := app.dustSweepKeeper.GetOptIn(ctx, sender)
+	require.False(t, found)
+
+	app.dustSweepKeeper.AddOptIn(ctx, sender)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = decorator.AnteHandle(ctx, send, false, next)
+	require.NoError(t, err)
+	optIn, found := app.dustSweepKeeper.GetOptIn(ctx, sender)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight(), optIn.LastActiveHeight)
+}
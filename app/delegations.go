@@ -0,0 +1,99 @@
+package app
+
+import (
+	"sort"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DelegatorShare defines the stake a delegator holds with a validator.
+// The stake of a delegator with a validator is Bonded + Unbonding.
+type DelegatorShare struct {
+	Delegator string  `json:"delegator"`
+	Validator string  `json:"validator"`
+	Shares    sdk.Dec `json:"shares"`
+	// tokens the delegation shares are currently worth
+	Bonded sdk.Int `json:"bonded"`
+	// tokens being unbonded from the validator
+	Unbonding sdk.Int `json:"unbonding"`
+	// tokens redelegated to the validator whose redelegation has not matured yet.
+	// They are already counted in Bonded, or in Unbonding once unbonded again,
+	// so this amount is informational and must not be added to the stake.
+	Redelegating sdk.Int `json:"redelegating"`
+}
+
+// ExportDelegatorShares returns the stake of every delegator with every
+// validator at the last committed height, ordered by delegator and validator.
+func (app *IrisApp) ExportDelegatorShares() []DelegatorShare {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	shares := make(map[string]*DelegatorShare)
+	get := func(delegator, validator string) *DelegatorShare {
+		key := delegator + "/" + validator
+		if s, ok := shares[key]; ok {
+			return s
+		}
+		s := &DelegatorShare{
+			Delegator:    delegator,
+			Validator:    validator,
+			Shares:       sdk.ZeroDec(),
+			Bonded:       sdk.ZeroInt(),
+			Unbonding:    sdk.ZeroInt(),
+			Redelegating: sdk.ZeroInt(),
+		}
+		shares[key] = s
+		return s
+	}
+
+	app.stakingKeeper.IterateAllDelegations(ctx, func(delegation stakingtypes.Delegation) bool {
+		validator, found := app.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return false
+		}
+		s := get(delegation.DelegatorAddress, delegation.ValidatorAddress)
+		s.Shares = delegation.Shares
+		s.Bonded = validator.TokensFromShares(delegation.Shares).TruncateInt()
+		return false
+	})
+
+	app.stakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd stakingtypes.UnbondingDelegation) bool {
+		s := get(ubd.DelegatorAddress, ubd.ValidatorAddress)
+		for _, entry := range ubd.Entries {
+			s.Unbonding = s.Unbonding.Add(entry.Balance)
+		}
+		return false
+	})
+
+	// redelegated tokens are bonded with the destination validator, so value the
+	// destination shares at its current exchange rate, which reflects slashing
+	app.stakingKeeper.IterateRedelegations(ctx, func(_ int64, red stakingtypes.Redelegation) bool {
+		valDstAddr, err := sdk.ValAddressFromBech32(red.ValidatorDstAddress)
+		if err != nil {
+			panic(err)
+		}
+		validator, found := app.stakingKeeper.GetValidator(ctx, valDstAddr)
+		if !found {
+			return false
+		}
+		s := get(red.DelegatorAddress, red.ValidatorDstAddress)
+		for _, entry := range red.Entries {
+			s.Redelegating = s.Redelegating.Add(validator.TokensFromShares(entry.SharesDst).TruncateInt())
+		}
+		return false
+	})
+
+	result := make([]DelegatorShare, 0, len(shares))
+	for _, s := range shares {
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Delegator != result[j].Delegator {
+			return result[i].Delegator < result[j].Delegator
+		}
+		return result[i].Validator < result[j].Validator
+	})
+	return result
+}
@@ -26,6 +26,13 @@ func (app *IrisApp) ExportAppStateAndValidators(
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
 
+	// apply the export settings agreed on by the upgrade plan the chain halted at
+	if export := app.getHardForkExport(ctx); export != nil {
+		app.Logger().Info("applying export settings of upgrade plan", "for_zero_height", export.ForZeroHeight)
+		forZeroHeight = forZeroHeight || export.ForZeroHeight
+		jailAllowedAddrs = append(jailAllowedAddrs, export.JailAllowedAddrs...)
+	}
+
 	// We export at last height + 1, because that's the height at which
 	// Tendermint will start InitChain.
 	height := app.LastBlockHeight() + 1
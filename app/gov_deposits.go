@@ -0,0 +1,139 @@
+package app
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	govdepositkeeper "github.com/irisnet/irishub/modules/govdeposit/keeper"
+	govdeposittypes "github.com/irisnet/irishub/modules/govdeposit/types"
+	govquorumkeeper "github.com/irisnet/irishub/modules/govquorum/keeper"
+	govquorumtypes "github.com/irisnet/irishub/modules/govquorum/types"
+	paramhistorytypes "github.com/irisnet/irishub/modules/paramhistory/types"
+)
+
+// govModule wraps the gov module to settle the deposits of the tallied
+// proposals by the policy of the govdeposit module rather than the gov one,
+// and to tally the re-submitted proposals which repeatedly missed the quorum
+// with the reduced quorum of the govquorum module
+type govModule struct {
+	gov.AppModule
+
+	keeper        govkeeper.Keeper
+	depositKeeper govdepositkeeper.Keeper
+	quorumKeeper  govquorumkeeper.Keeper
+}
+
+func newGovModule(am gov.AppModule, k govkeeper.Keeper, dk govdepositkeeper.Keeper, qk govquorumkeeper.Keeper) govModule {
+	return govModule{
+		AppModule:     am,
+		keeper:        k,
+		depositKeeper: dk,
+		quorumKeeper:  qk,
+	}
+}
+
+// EndBlock returns the end blocker for the gov module. The proposals whose
+// voting period ends are held out of the active queue, then gov tallies them
+// one at a time in a context carrying the proposal ID, so that the proposal
+// handlers know which proposal they execute. The deposits of each proposal are
+// prepared for settlement before the gov end blocker refunds or burns them,
+// and the settlements are reported once the proposals are tallied.
+//
+// The proposals in a reduced quorum window are tallied last with the reduced
+// quorum set in the tally params, which are restored afterwards unless one of
+// them passed and changed the tally params.
+func (am govModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	var proposals, reduced []govtypes.Proposal
+	var quorum sdk.Dec
+	am.keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal govtypes.Proposal) bool {
+		if q, ok := am.quorumKeeper.ReducedQuorum(ctx, proposal); ok {
+			reduced, quorum = append(reduced, proposal), q
+			return false
+		}
+		proposals = append(proposals, proposal)
+		return false
+	})
+
+	for _, proposal := range append(proposals, reduced...) {
+		am.keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	}
+
+	// the inactive proposals are handled with the active queue held out
+	updates := am.AppModule.EndBlock(ctx, req)
+	settlements, tallyUpdates := am.tally(ctx, req, proposals)
+	updates = append(updates, tallyUpdates...)
+
+	if len(reduced) > 0 {
+		tallyParams := am.keeper.GetTallyParams(ctx)
+		reducedParams := tallyParams
+		if quorum.LT(tallyParams.Quorum) {
+			reducedParams.Quorum = quorum
+		}
+		am.keeper.SetTallyParams(ctx, reducedParams)
+
+		for _, proposal := range reduced {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					govquorumtypes.EventTypeReducedQuorum,
+					sdk.NewAttribute(govquorumtypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(govquorumtypes.AttributeKeyQuorum, reducedParams.Quorum.String()),
+				),
+			)
+		}
+		reducedSettlements, tallyUpdates := am.tally(ctx, req, reduced)
+		settlements = append(settlements, reducedSettlements...)
+		updates = append(updates, tallyUpdates...)
+
+		if !am.changesTallyParams(ctx, reduced) {
+			am.keeper.SetTallyParams(ctx, tallyParams)
+		}
+	}
+
+	tallied := append(proposals, reduced...)
+	for i, settlement := range settlements {
+		am.depositKeeper.CompleteSettlement(ctx, settlement)
+		am.quorumKeeper.RecordOutcome(ctx, tallied[i], settlement.Outcome == govdeposittypes.OutcomeQuorumMissed)
+	}
+	return updates
+}
+
+// tally puts the proposals held out of the active queue back one at a time
+// and runs the gov end blocker for each of them, returning the settlements of
+// their deposits prepared beforehand
+func (am govModule) tally(
+	ctx sdk.Context, req abci.RequestEndBlock, proposals []govtypes.Proposal,
+) (settlements []govdeposittypes.Settlement, updates []abci.ValidatorUpdate) {
+	for _, proposal := range proposals {
+		am.keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		settlements = append(settlements, am.depositKeeper.PrepareSettlement(ctx, proposal))
+		updates = append(updates, am.AppModule.EndBlock(paramhistorytypes.WithExecutingProposalID(ctx, proposal.ProposalId), req)...)
+	}
+	return settlements, updates
+}
+
+// changesTallyParams returns true if one of the tallied proposals passed and
+// changed the gov tally params
+func (am govModule) changesTallyParams(ctx sdk.Context, proposals []govtypes.Proposal) bool {
+	for _, proposal := range proposals {
+		content, ok := proposal.GetContent().(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			continue
+		}
+		if proposal, found := am.keeper.GetProposal(ctx, proposal.ProposalId); !found || proposal.Status != govtypes.StatusPassed {
+			continue
+		}
+		for _, change := range content.Changes {
+			if change.Subspace == govtypes.ModuleName && change.Key == string(govtypes.ParamStoreKeyTallyParams) {
+				return true
+			}
+		}
+	}
+	return false
+}
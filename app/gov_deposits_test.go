@@ -0,0 +1,83 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	govdeposittypes "github.com/irisnet/irishub/modules/govdeposit/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestGovModuleDeposits(t *testing.T) {
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Now()})
+	minDeposit := app.govKeeper.GetDepositParams(ctx).MinDeposit
+	denom := minDeposit[0].Denom
+
+	// the proposal gets no vote, which misses the quorum of the bonded tokens
+	bonded := sdk.NewCoins(sdk.NewCoin(denom, minDeposit[0].Amount.MulRaw(10)))
+	require.NoError(t, app.bankKeeper.MintCoins(ctx, minttypes.ModuleName, bonded))
+	require.NoError(t, app.bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, stakingtypes.BondedPoolName, bonded))
+
+	depositor := sdk.AccAddress([]byte("govdeposit-depositor"))
+	require.NoError(t, app.bankKeeper.MintCoins(ctx, minttypes.ModuleName, minDeposit))
+	require.NoError(t, app.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, depositor, minDeposit))
+
+	proposal, err := app.govKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("title", "description"))
+	require.NoError(t, err)
+	activated, err := app.govKeeper.AddDeposit(ctx, proposal.ProposalId, depositor, minDeposit)
+	require.NoError(t, err)
+	require.True(t, activated)
+
+	proposal, _ = app.govKeeper.GetProposal(ctx, proposal.ProposalId)
+	ctx = ctx.WithBlockTime(proposal.VotingEndTime).WithEventManager(sdk.NewEventManager())
+	supplyBefore := app.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+
+	am := newGovModule(
+		gov.NewAppModule(app.appCodec, app.govKeeper, app.accountKeeper, app.bankKeeper),
+		app.govKeeper, app.govDepositKeeper, app.govQuorumKeeper,
+	)
+	am.EndBlock(ctx, abci.RequestEndBlock{})
+
+	proposal, _ = app.govKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.Equal(t, govtypes.StatusRejected, proposal.Status)
+	require.Empty(t, app.govKeeper.GetDeposits(ctx, proposal.ProposalId))
+
+	rate := app.govDepositKeeper.GetParamSet(ctx).QuorumMissedBurnRate
+	burned := rate.MulInt(minDeposit[0].Amount).TruncateInt()
+	require.True(t, minDeposit[0].Amount.Sub(burned).Equal(app.bankKeeper.GetBalance(ctx, depositor, denom).Amount))
+	require.True(t, supplyBefore.Sub(burned).Equal(app.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)))
+
+	var outcome string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != govdeposittypes.EventTypeProposalDeposits {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == govdeposittypes.AttributeKeyOutcome {
+				outcome = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, string(govdeposittypes.OutcomeQuorumMissed), outcome)
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HardForkExport defines the export settings agreed on by a software upgrade
+// proposal for a hard fork. They are carried in the plan info as JSON, e.g.
+// {"export":{"for_zero_height":true,"jail_allowed_addrs":["iva1..."]}}, and
+// applied by `iris export` once the chain has halted at the plan height.
+type HardForkExport struct {
+	ForZeroHeight    bool     `json:"for_zero_height"`
+	JailAllowedAddrs []string `json:"jail_allowed_addrs"`
+}
+
+// ParseHardForkExport returns the export settings carried in the given plan
+// info, if any. Plan infos which are not JSON objects or have no export field
+// carry no settings; an export field which does not decode is an error.
+func ParseHardForkExport(info string) (*HardForkExport, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(info), &fields); err != nil {
+		return nil, nil
+	}
+
+	raw, ok := fields["export"]
+	if !ok {
+		return nil, nil
+	}
+
+	var export *HardForkExport
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&export); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid export settings: %s", err)
+	}
+	if export == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid export settings: expected an object")
+	}
+
+	for _, addr := range export.JailAllowedAddrs {
+		if _, err := sdk.ValAddressFromBech32(addr); err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid jail allowed address %s: %s", addr, err)
+		}
+	}
+	return export, nil
+}
+
+// getHardForkExport returns the export settings of the height-based upgrade
+// plan the chain has halted at, if any
+func (app *IrisApp) getHardForkExport(ctx sdk.Context) *HardForkExport {
+	plan, found := app.upgradeKeeper.GetUpgradePlan(ctx)
+	if !found || plan.Height != app.LastBlockHeight()+1 {
+		return nil
+	}
+
+	export, err := ParseHardForkExport(plan.Info)
+	if err != nil {
+		app.Logger().Error("ignoring invalid export settings of upgrade plan", "plan", plan.Name, "err", err)
+		return nil
+	}
+	return export
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	featuregatetypes "github.com/irisnet/irishub/modules/featuregate/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestMintBlockTimeOracle(t *testing.T) {
+	app, ctx, _ := setupCoinswapTest(t)
+	oracle := newMintBlockTimeOracle(app.featureGateKeeper, app.blockTimeKeeper)
+
+	start := ctx.BlockTime()
+	for height := int64(1); height <= 5; height++ {
+		app.blockTimeKeeper.RecordBlockTime(ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * 7 * time.Second)))
+	}
+	ctx = ctx.WithBlockHeight(10)
+
+	// the default block time until the feature is active
+	require.Equal(t, minttypes.DefaultBlockTime, oracle.MedianBlockTime(ctx))
+
+	app.featureGateKeeper.SetParamSet(ctx, featuregatetypes.NewParams([]featuregatetypes.FeatureGate{
+		featuregatetypes.NewFeatureGate(FeatureMeasuredBlockTime, 10),
+	}))
+	require.Equal(t, 7*time.Second, oracle.MedianBlockTime(ctx))
+	require.Equal(t, minttypes.DefaultBlockTime, oracle.MedianBlockTime(ctx.WithBlockHeight(9)))
+}
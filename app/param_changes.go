@@ -0,0 +1,78 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	featuregatetypes "github.com/irisnet/irishub/modules/featuregate/types"
+)
+
+// ValidateParamChanges checks the changes of a parameter change proposal, which may
+// span several subspaces, against the param sets of their subspaces. The changes
+// are applied in order to a cache of the state which is then discarded, as the
+// proposal applies them on pass. A parameter may only be changed once: the params
+// module applies duplicate changes in order, the last one winning, which a voter
+// reading the first one would not expect. The feature gates active at the current
+// height must be kept as they are.
+func ValidateParamChanges(ctx sdk.Context, pk paramskeeper.Keeper, changes []proposal.ParamChange) error {
+	cacheCtx, _ := ctx.CacheContext()
+
+	changed := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		param := fmt.Sprintf("%s/%s", change.Subspace, change.Key)
+		if changed[param] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate change of %s, which would be overwritten by the last one", param)
+		}
+		changed[param] = true
+
+		subspace, ok := pk.GetSubspace(change.Subspace)
+		if !ok {
+			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, change.Subspace)
+		}
+		if err := updateParam(cacheCtx, subspace, change); err != nil {
+			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "%s: %s", param, err)
+		}
+
+		if change.Subspace == featuregatetypes.ModuleName && change.Key == string(featuregatetypes.KeyGates) {
+			var gates, updated []featuregatetypes.FeatureGate
+			subspace.GetIfExists(ctx, featuregatetypes.KeyGates, &gates)
+			subspace.Get(cacheCtx, featuregatetypes.KeyGates, &updated)
+			if err := featuregatetypes.ValidateGateUpdate(ctx.BlockHeight(), gates, updated); err != nil {
+				return sdkerrors.Wrapf(proposal.ErrSettingParameter, "%s: %s", param, err)
+			}
+		}
+	}
+	return nil
+}
+
+// NewParamChangeProposalHandler wraps the given ParamChangeProposal handler to
+// validate the changes again with ValidateParamChanges once the proposal passes,
+// as the state may have changed during the voting period, e.g. a scheduled
+// feature gate may have been activated
+func NewParamChangeProposalHandler(pk paramskeeper.Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if p, ok := content.(*proposal.ParameterChangeProposal); ok {
+			if err := ValidateParamChanges(ctx, pk, p.Changes); err != nil {
+				return err
+			}
+		}
+		return handler(ctx, content)
+	}
+}
+
+// updateParam applies a parameter change to the given subspace. The subspace
+// panics on the keys its param set does not register.
+func updateParam(ctx sdk.Context, subspace paramstypes.Subspace, change proposal.ParamChange) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return subspace.Update(ctx, []byte(change.Key), []byte(change.Value))
+}
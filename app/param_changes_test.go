@@ -0,0 +1,101 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+
+	featuregatetypes "github.com/irisnet/irishub/modules/featuregate/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestValidateParamChanges(t *testing.T) {
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	serviceFeeTax := proposal.NewParamChange(servicetypes.ModuleName, string(servicetypes.KeyServiceFeeTax), `"0.05"`)
+	tokenTaxRate := proposal.NewParamChange(tokentypes.ModuleName, string(tokentypes.KeyTokenTaxRate), `"0.3"`)
+	coinswapFee := proposal.NewParamChange(coinswaptypes.ModuleName, string(coinswaptypes.KeyFee), `"0.004"`)
+	inflation := proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyInflation), `"0.05"`)
+
+	testCases := []struct {
+		msg     string
+		changes []proposal.ParamChange
+		expPass bool
+	}{
+		{"several modules", []proposal.ParamChange{serviceFeeTax, tokenTaxRate, coinswapFee, inflation}, true},
+		{"unknown subspace", []proposal.ParamChange{serviceFeeTax, proposal.NewParamChange("asset", "TokenTaxRate", `"0.3"`)}, false},
+		{"unregistered key", []proposal.ParamChange{proposal.NewParamChange(servicetypes.ModuleName, "FeeTax", `"0.05"`)}, false},
+		{"invalid value", []proposal.ParamChange{inflation, proposal.NewParamChange(servicetypes.ModuleName, string(servicetypes.KeyServiceFeeTax), `"1"`)}, false},
+		{"malformed value", []proposal.ParamChange{proposal.NewParamChange(coinswaptypes.ModuleName, string(coinswaptypes.KeyFee), `0.004`)}, false},
+		{"duplicate change", []proposal.ParamChange{inflation, coinswapFee, inflation}, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateParamChanges(ctx, app.paramsKeeper, tc.changes)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+
+	// the changes are not applied
+	require.Equal(t, servicetypes.DefaultParams().ServiceFeeTax, app.serviceKeeper.GetParams(ctx).ServiceFeeTax)
+}
+
+func TestValidateActiveGateChanges(t *testing.T) {
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 100})
+	app.featureGateKeeper.SetParamSet(ctx, featuregatetypes.NewParams([]featuregatetypes.FeatureGate{
+		featuregatetypes.NewFeatureGate(FeatureTextValidation, 50),
+	}))
+
+	gates := func(value string) []proposal.ParamChange {
+		return []proposal.ParamChange{proposal.NewParamChange(featuregatetypes.ModuleName, string(featuregatetypes.KeyGates), value)}
+	}
+	kept := gates(`[{"name":"text-validation","activation_height":"50"},{"name":"batch-dispatch","activation_height":"200"}]`)
+	rescheduled := gates(`[{"name":"text-validation","activation_height":"150"}]`)
+	removed := gates(`[{"name":"batch-dispatch","activation_height":"200"}]`)
+
+	require.NoError(t, ValidateParamChanges(ctx, app.paramsKeeper, kept))
+	require.Error(t, ValidateParamChanges(ctx, app.paramsKeeper, rescheduled))
+	require.Error(t, ValidateParamChanges(ctx, app.paramsKeeper, removed))
+
+	// the gate is not active yet when the proposal is submitted, but is once it passes
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, ValidateParamChanges(ctx, app.paramsKeeper, removed))
+
+	handler := app.govKeeper.Router().GetRoute(proposal.RouterKey)
+	ctx = ctx.WithBlockHeight(100)
+	require.Error(t, handler(ctx, proposal.NewParameterChangeProposal("title", "description", removed)))
+	require.True(t, app.featureGateKeeper.IsFeatureActive(ctx, FeatureTextValidation))
+
+	require.NoError(t, handler(ctx, proposal.NewParameterChangeProposal("title", "description", kept)))
+	_, found := app.featureGateKeeper.GetFeatureGate(ctx, "batch-dispatch")
+	require.True(t, found)
+}
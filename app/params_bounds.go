@@ -0,0 +1,62 @@
+package app
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+// ParamBound defines the inclusive range a decimal parameter can be set to by governance
+type ParamBound struct {
+	Min sdk.Dec
+	Max sdk.Dec
+}
+
+type paramBoundKey struct {
+	subspace string
+	key      string
+}
+
+// paramBounds is the registry of the allowed ranges of the economic parameters
+var paramBounds = map[paramBoundKey]ParamBound{
+	{distrtypes.ModuleName, string(distrtypes.ParamStoreKeyCommunityTax)}: {sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1)},
+	{servicetypes.ModuleName, string(servicetypes.KeyServiceFeeTax)}:      {sdk.ZeroDec(), sdk.NewDecWithPrec(2, 1)},
+	{minttypes.ModuleName, string(minttypes.KeyInflation)}:                {sdk.ZeroDec(), sdk.NewDecWithPrec(2, 1)},
+	{coinswaptypes.ModuleName, string(coinswaptypes.KeyFee)}:              {sdk.ZeroDec(), sdk.NewDecWithPrec(1, 1)},
+}
+
+// GetParamBound returns the allowed range of the given parameter, if it is bounded
+func GetParamBound(subspace, key string) (ParamBound, bool) {
+	bound, ok := paramBounds[paramBoundKey{subspace, key}]
+	return bound, ok
+}
+
+// ValidateParamBounds checks that the given parameter change is within the
+// allowed range of the parameter, if it is bounded
+func ValidateParamBounds(change proposal.ParamChange) error {
+	bound, ok := GetParamBound(change.Subspace, change.Key)
+	if !ok {
+		return nil
+	}
+
+	var value sdk.Dec
+	if err := json.Unmarshal([]byte(change.Value), &value); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid value of %s/%s: %s", change.Subspace, change.Key, err)
+	}
+
+	if value.LT(bound.Min) || value.GT(bound.Max) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "%s/%s must be between [%s, %s]: %s",
+			change.Subspace, change.Key, bound.Min, bound.Max, value,
+		)
+	}
+	return nil
+}
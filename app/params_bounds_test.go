@@ -0,0 +1,41 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestValidateParamBounds(t *testing.T) {
+	communityTax := string(distrtypes.ParamStoreKeyCommunityTax)
+	inflation := string(minttypes.KeyInflation)
+
+	testCases := []struct {
+		msg     string
+		change  proposal.ParamChange
+		expPass bool
+	}{
+		{"within bounds", proposal.NewParamChange(distrtypes.ModuleName, communityTax, `"0.02"`), true},
+		{"lower bound", proposal.NewParamChange(minttypes.ModuleName, inflation, `"0"`), true},
+		{"upper bound", proposal.NewParamChange(minttypes.ModuleName, inflation, `"0.2"`), true},
+		{"above upper bound", proposal.NewParamChange(minttypes.ModuleName, inflation, `"0.21"`), false},
+		{"negative", proposal.NewParamChange(distrtypes.ModuleName, communityTax, `"-0.01"`), false},
+		{"malformed", proposal.NewParamChange(distrtypes.ModuleName, communityTax, `0.02`), false},
+		{"unbounded", proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), `1000`), true},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateParamBounds(tc.change)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cast"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmos "github.com/tendermint/tendermint/libs/os"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+const (
+	// FlagAPISignResponses enables signing the API query responses
+	FlagAPISignResponses = "api.sign-responses"
+	// FlagAPISignResponsesKeyFile is the file of the key the API query responses are signed with
+	FlagAPISignResponsesKeyFile = "api.sign-responses-key-file"
+
+	// DefaultResponseKeyFile is the default response signing key file, relative to the home directory
+	DefaultResponseKeyFile = "config/api_response_key.json"
+
+	// ResponseSignDomain prefixes the signed bytes of every API response, so that
+	// a response signature can't be mistaken for a signature of another protocol
+	ResponseSignDomain = "irishub-api-response/v1"
+
+	// appHashesKept is the number of recent heights whose app hash is kept
+	appHashesKept = 100
+)
+
+// Headers added to signed API responses
+const (
+	HeaderResponseHeight    = "X-Iris-Height"
+	HeaderResponseAppHash   = "X-Iris-App-Hash"
+	HeaderResponsePubKey    = "X-Iris-Public-Key"
+	HeaderResponseSignature = "X-Iris-Signature"
+)
+
+// grpcGatewayHeightHeader is the header the gRPC gateway forwards the query height in
+var grpcGatewayHeightHeader = "Grpc-Metadata-" + grpctypes.GRPCBlockHeightHeader
+
+// ResponseSigner signs the responses of API queries with the key registered
+// for the gateway, so that consumers of a gateway can verify which gateway
+// served a response and at which height and app hash it was computed.
+// The key is dedicated to API responses: the node key also authenticates the
+// p2p connections and must not sign anything else.
+type ResponseSigner struct {
+	privKey crypto.PrivKey
+
+	mtx       sync.RWMutex
+	appHashes map[int64][]byte // app hashes of the recent heights
+}
+
+// NewResponseSigner creates a ResponseSigner from the given private key
+func NewResponseSigner(privKey crypto.PrivKey) *ResponseSigner {
+	return &ResponseSigner{
+		privKey:   privKey,
+		appHashes: make(map[int64][]byte),
+	}
+}
+
+// responseKey is the content of the response signing key file
+type responseKey struct {
+	PrivKey crypto.PrivKey `json:"priv_key"`
+}
+
+// loadResponseSigner returns the response signer if response signing is
+// enabled in the app options, otherwise nil. The signing key is generated
+// on the first start if its file does not exist yet.
+func loadResponseSigner(logger log.Logger, homePath string, appOpts servertypes.AppOptions) *ResponseSigner {
+	if !cast.ToBool(appOpts.Get(FlagAPISignResponses)) {
+		return nil
+	}
+
+	keyFile := cast.ToString(appOpts.Get(FlagAPISignResponsesKeyFile))
+	if len(keyFile) == 0 {
+		keyFile = DefaultResponseKeyFile
+	}
+	if !filepath.IsAbs(keyFile) {
+		keyFile = filepath.Join(homePath, keyFile)
+	}
+
+	privKey, err := loadOrGenResponseKey(keyFile)
+	if err != nil {
+		panic(fmt.Errorf("failed to load the key to sign API responses: %w", err))
+	}
+
+	signer := NewResponseSigner(privKey)
+	logger.Info("signing API responses", "key_file", keyFile,
+		"pub_key", base64.StdEncoding.EncodeToString(signer.PubKey().Bytes()))
+	return signer
+}
+
+// loadOrGenResponseKey loads the response signing key from the given file,
+// generating and saving a new ed25519 key if the file does not exist.
+func loadOrGenResponseKey(keyFile string) (crypto.PrivKey, error) {
+	if tmos.FileExists(keyFile) {
+		bz, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		var key responseKey
+		if err := tmjson.Unmarshal(bz, &key); err != nil {
+			return nil, fmt.Errorf("invalid key file %s: %w", keyFile, err)
+		}
+		return key.PrivKey, nil
+	}
+
+	key := responseKey{PrivKey: ed25519.GenPrivKey()}
+	bz, err := tmjson.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := tmos.EnsureDir(filepath.Dir(keyFile), 0700); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(keyFile, bz, 0600); err != nil {
+		return nil, err
+	}
+	return key.PrivKey, nil
+}
+
+// PubKey returns the public key the responses are signed with
+func (s *ResponseSigner) PubKey() crypto.PubKey {
+	return s.privKey.PubKey()
+}
+
+// RecordAppHash records the app hash of the state committed at the given
+// height, as carried by the header of the next block, keeping the recent ones.
+func (s *ResponseSigner) RecordAppHash(height int64, appHash []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.appHashes[height] = appHash
+	delete(s.appHashes, height-appHashesKept)
+}
+
+// appHash returns the recorded app hash of the given height, if any
+func (s *ResponseSigner) appHash(height int64) []byte {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.appHashes[height]
+}
+
+// Middleware returns a router middleware signing the successful responses to
+// GET requests. The app hash is the one recorded for the served height, and is
+// left empty until the block after that height has begun.
+func (s *ResponseSigner) Middleware() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			rw := newBufferedResponseWriter()
+			next.ServeHTTP(rw, r)
+
+			for key, values := range rw.header {
+				w.Header()[key] = values
+			}
+
+			body := rw.body.Bytes()
+			if rw.status == http.StatusOK {
+				if height, ok := responseHeight(rw.header, body); ok {
+					if err := s.signResponse(w.Header(), height, s.appHash(height), body); err != nil {
+						http.Error(w, err.Error(), http.StatusInternalServerError)
+						return
+					}
+				}
+			}
+
+			w.WriteHeader(rw.status)
+			_, _ = w.Write(body)
+		})
+	}
+}
+
+func (s *ResponseSigner) signResponse(header http.Header, height int64, appHash, body []byte) error {
+	sig, err := s.privKey.Sign(ResponseSignBytes(height, appHash, body))
+	if err != nil {
+		return err
+	}
+
+	header.Set(HeaderResponseHeight, strconv.FormatInt(height, 10))
+	header.Set(HeaderResponseAppHash, hex.EncodeToString(appHash))
+	header.Set(HeaderResponsePubKey, base64.StdEncoding.EncodeToString(s.PubKey().Bytes()))
+	header.Set(HeaderResponseSignature, base64.StdEncoding.EncodeToString(sig))
+	return nil
+}
+
+// ResponseSignBytes returns the bytes signed for an API response: the
+// ResponseSignDomain tag followed by the SHA-256 hash of the height, the hex
+// encoded app hash and the body separated by new lines.
+func ResponseSignBytes(height int64, appHash, body []byte) []byte {
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%d\n%X\n", height, appHash)
+	_, _ = hash.Write(body)
+	return append([]byte(ResponseSignDomain), hash.Sum(nil)...)
+}
+
+// VerifyResponseSignature verifies the signature of an API response against
+// the public key of the node expected to have served it.
+func VerifyResponseSignature(pubKey crypto.PubKey, header http.Header, body []byte) error {
+	height, err := strconv.ParseInt(header.Get(HeaderResponseHeight), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s header: %w", HeaderResponseHeight, err)
+	}
+	appHash, err := hex.DecodeString(header.Get(HeaderResponseAppHash))
+	if err != nil {
+		return fmt.Errorf("invalid %s header: %w", HeaderResponseAppHash, err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(header.Get(HeaderResponseSignature))
+	if err != nil {
+		return fmt.Errorf("invalid %s header: %w", HeaderResponseSignature, err)
+	}
+
+	if !pubKey.VerifySignature(ResponseSignBytes(height, appHash, body), sig) {
+		return fmt.Errorf("invalid response signature")
+	}
+	return nil
+}
+
+// responseHeight returns the height a response was computed at, taken from
+// the gRPC gateway header or from the body of a legacy REST response.
+func responseHeight(header http.Header, body []byte) (int64, bool) {
+	if h := header.Get(grpcGatewayHeightHeader); len(h) > 0 {
+		height, err := strconv.ParseInt(h, 10, 64)
+		return height, err == nil
+	}
+
+	var res struct {
+		Height string `json:"height"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil || len(res.Height) == 0 {
+		return 0, false
+	}
+
+	height, err := strconv.ParseInt(res.Height, 10, 64)
+	return height, err == nil
+}
+
+// bufferedResponseWriter holds a response until it has been signed
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBufferedResponseWriter() *bufferedResponseWriter {
+	return &bufferedResponseWriter{
+		header: make(http.Header),
+		status: http.StatusOK,
+	}
+}
+
+func (w *bufferedResponseWriter) Header() http.Header { return w.header }
+
+func (w *bufferedResponseWriter) Write(bz []byte) (int, error) { return w.body.Write(bz) }
+
+func (w *bufferedResponseWriter) WriteHeader(status int) { w.status = status }
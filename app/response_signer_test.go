@@ -0,0 +1,92 @@
+package app
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestResponseSignerMiddleware(t *testing.T) {
+	signer := NewResponseSigner(ed25519.GenPrivKey())
+	appHash := sha256.Sum256([]byte("state"))
+	signer.RecordAppHash(10, appHash[:])
+
+	testCases := []struct {
+		msg     string
+		method  string
+		header  map[string]string
+		body    string
+		expSign bool
+	}{
+		{"legacy response", http.MethodGet, nil, `{"height":"10","result":{}}`, true},
+		{"grpc gateway response", http.MethodGet, map[string]string{grpcGatewayHeightHeader: "12"}, `{"params":{}}`, true},
+		{"no height", http.MethodGet, nil, `{"params":{}}`, false},
+		{"not a query", http.MethodPost, nil, `{"height":"10","result":{}}`, false},
+	}
+
+	for _, tc := range testCases {
+		handler := signer.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for key, value := range tc.header {
+				w.Header().Set(key, value)
+			}
+			_, _ = w.Write([]byte(tc.body))
+		}))
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(tc.method, "/", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code, tc.msg)
+		require.Equal(t, tc.body, rec.Body.String(), tc.msg)
+
+		if !tc.expSign {
+			require.Empty(t, rec.Header().Get(HeaderResponseSignature), tc.msg)
+			continue
+		}
+
+		if rec.Header().Get(HeaderResponseHeight) == "10" {
+			require.Equal(t, hex.EncodeToString(appHash[:]), rec.Header().Get(HeaderResponseAppHash), tc.msg)
+		} else {
+			require.Empty(t, rec.Header().Get(HeaderResponseAppHash), tc.msg)
+		}
+
+		require.NoError(t, VerifyResponseSignature(signer.PubKey(), rec.Header(), rec.Body.Bytes()), tc.msg)
+		require.Error(t, VerifyResponseSignature(signer.PubKey(), rec.Header(), []byte(`{}`)), tc.msg)
+		require.Error(t, VerifyResponseSignature(ed25519.GenPrivKey().PubKey(), rec.Header(), rec.Body.Bytes()), tc.msg)
+	}
+}
+
+func TestResponseSignBytes(t *testing.T) {
+	signBytes := ResponseSignBytes(10, []byte{0x01}, []byte(`{}`))
+	require.True(t, bytes.HasPrefix(signBytes, []byte(ResponseSignDomain)))
+	require.NotEqual(t, signBytes, ResponseSignBytes(11, []byte{0x01}, []byte(`{}`)))
+}
+
+func TestRecordAppHash(t *testing.T) {
+	signer := NewResponseSigner(ed25519.GenPrivKey())
+	for height := int64(1); height <= appHashesKept+10; height++ {
+		signer.RecordAppHash(height, []byte{byte(height)})
+	}
+
+	require.Len(t, signer.appHashes, appHashesKept)
+	require.Nil(t, signer.appHash(10))
+	require.Equal(t, []byte{byte(appHashesKept + 10)}, signer.appHash(appHashesKept+10))
+}
+
+func TestLoadOrGenResponseKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "config", "api_response_key.json")
+
+	privKey, err := loadOrGenResponseKey(keyFile)
+	require.NoError(t, err)
+
+	loaded, err := loadOrGenResponseKey(keyFile)
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(loaded))
+}
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/irisnet/irismod/modules/service"
+	servicekeeper "github.com/irisnet/irismod/modules/service/keeper"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+
+	payoutkeeper "github.com/irisnet/irishub/modules/payout/keeper"
+)
+
+// serviceModule wraps the service module to route its messages through
+// serviceMsgServer and to record the slashes of its end blocker in the
+// provider payout reports
+type serviceModule struct {
+	service.AppModule
+
+	msgServer    servicetypes.MsgServer
+	keeper       servicekeeper.Keeper
+	payoutKeeper payoutkeeper.Keeper
+}
+
+func newServiceModule(am service.AppModule, k servicekeeper.Keeper, pk payoutkeeper.Keeper) serviceModule {
+	return serviceModule{
+		AppModule:    am,
+		msgServer:    newServiceMsgServer(k, pk),
+		keeper:       k,
+		payoutKeeper: pk,
+	}
+}
+
+// RegisterServices registers module services.
+func (am serviceModule) RegisterServices(cfg module.Configurator) {
+	servicetypes.RegisterMsgServer(cfg.MsgServer(), am.msgServer)
+	servicetypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
+
+// Route returns the message routing key for the service module.
+func (am serviceModule) Route() sdk.Route {
+	handler := service.NewHandler(am.keeper)
+
+	return sdk.NewRoute(servicetypes.RouterKey, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		respondMsg, ok := msg.(*servicetypes.MsgRespondService)
+		if !ok {
+			return handler(ctx, msg)
+		}
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		res, err := am.msgServer.RespondService(sdk.WrapSDKContext(ctx), respondMsg)
+		return sdk.WrapServiceResult(ctx, res, err)
+	})
+}
+
+// EndBlock returns the end blocker for the service module, which slashes the
+// providers of the expired requests. The slashes are read from the events
+// the end blocker emits.
+func (am serviceModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	emitted := len(ctx.EventManager().Events())
+	updates := am.AppModule.EndBlock(ctx, req)
+
+	for _, event := range ctx.EventManager().Events()[emitted:] {
+		if event.Type != servicetypes.EventTypeServiceSlash {
+			continue
+		}
+
+		var provider sdk.AccAddress
+		var slashed sdk.Coins
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case servicetypes.AttributeKeyProvider:
+				provider, _ = sdk.AccAddressFromBech32(string(attr.Value))
+			case servicetypes.AttributeKeySlashedCoins:
+				slashed, _ = sdk.ParseCoinsNormalized(string(attr.Value))
+			}
+		}
+		if provider.Empty() {
+			continue
+		}
+
+		am.payoutKeeper.RecordSlash(ctx, provider, slashed)
+	}
+
+	return updates
+}
+
+// serviceMsgServer wraps the service msg server and records the fee earned
+// for every response, and the tax withheld from it, in the payout report of
+// the provider
+type serviceMsgServer struct {
+	servicetypes.MsgServer
+
+	keeper       servicekeeper.Keeper
+	payoutKeeper payoutkeeper.Keeper
+}
+
+func newServiceMsgServer(k servicekeeper.Keeper, pk payoutkeeper.Keeper) serviceMsgServer {
+	return serviceMsgServer{
+		MsgServer:    servicekeeper.NewMsgServerImpl(k),
+		keeper:       k,
+		payoutKeeper: pk,
+	}
+}
+
+// RespondService implements servicetypes.MsgServer
+func (s serviceMsgServer) RespondService(
+	goCtx context.Context, msg *servicetypes.MsgRespondService,
+) (*servicetypes.MsgRespondServiceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provider, err := sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return nil, err
+	}
+	requestID, err := hex.DecodeString(msg.RequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	request, found := s.keeper.GetRequest(ctx, requestID)
+	if !found {
+		return nil, sdkerrors.Wrap(servicetypes.ErrUnknownRequest, msg.RequestId)
+	}
+	earnedBefore, _ := s.keeper.GetEarnedFees(ctx, provider)
+
+	res, err := s.MsgServer.RespondService(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the service keeper withholds the tax from the request fee and adds the
+	// rest to the earned fees of the provider
+	earnedAfter, _ := s.keeper.GetEarnedFees(ctx, provider)
+	earned := earnedAfter.Sub(earnedBefore)
+	tax := request.ServiceFee.Sub(earned)
+
+	s.payoutKeeper.RecordResponse(ctx, provider, earned, tax)
+	return res, nil
+}
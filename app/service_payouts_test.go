@@ -0,0 +1,114 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irismod/modules/service"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+func TestServiceModulePayouts(t *testing.T) {
+	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Now()})
+	baseDenom := app.serviceKeeper.BaseDenom(ctx)
+
+	provider := sdk.AccAddress([]byte("payout-test-provider"))
+	consumer := sdk.AccAddress([]byte("payout-test-consumer"))
+	for _, addr := range []sdk.AccAddress{provider, consumer} {
+		funds := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1_000_000_000))
+		require.NoError(t, app.bankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+		require.NoError(t, app.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, funds))
+	}
+
+	am := newServiceModule(
+		service.NewAppModule(app.appCodec, app.serviceKeeper, app.accountKeeper, app.bankKeeper),
+		app.serviceKeeper, app.payoutKeeper,
+	)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err = am.msgServer.DefineService(goCtx, servicetypes.NewMsgDefineService(
+		"payout-test", "", nil, provider.String(), "",
+		`{"input":{"type":"object"},"output":{"type":"object"}}`,
+	))
+	require.NoError(t, err)
+
+	_, err = am.msgServer.BindService(goCtx, servicetypes.NewMsgBindService(
+		"payout-test", provider.String(), sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100_000_000)),
+		fmt.Sprintf(`{"price":"100%s"}`, baseDenom), 1, "{}", provider.String(),
+	))
+	require.NoError(t, err)
+
+	// callService sends a request to the provider and returns its ID
+	callService := func(ctx sdk.Context) tmbytes.HexBytes {
+		res, err := am.msgServer.CallService(sdk.WrapSDKContext(ctx), servicetypes.NewMsgCallService(
+			"payout-test", []string{provider.String()}, consumer.String(), `{"header":{},"body":{}}`,
+			sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)), 10, false, 0, 0,
+		))
+		require.NoError(t, err)
+
+		// the requests of a batch are initiated by the end blocker
+		am.EndBlock(ctx, abci.RequestEndBlock{})
+
+		requestContextID, err := hex.DecodeString(res.RequestContextId)
+		require.NoError(t, err)
+
+		var requestIDs []tmbytes.HexBytes
+		app.serviceKeeper.IterateActiveRequests(ctx, requestContextID, 1, func(requestID tmbytes.HexBytes, _ servicetypes.Request) {
+			requestIDs = append(requestIDs, requestID)
+		})
+		require.Len(t, requestIDs, 1)
+		return requestIDs[0]
+	}
+
+	requestID := callService(ctx)
+	_, err = am.msgServer.RespondService(goCtx, servicetypes.NewMsgRespondService(
+		requestID.String(), provider.String(), `{"code":200,"message":""}`, `{"header":{},"body":{}}`,
+	))
+	require.NoError(t, err)
+
+	epoch := app.payoutKeeper.GetEpoch(ctx).Number
+	report, found := app.payoutKeeper.GetReport(ctx, provider, epoch)
+	require.True(t, found)
+	require.Equal(t, uint64(1), report.Responses)
+
+	earned, _ := app.serviceKeeper.GetEarnedFees(ctx, provider)
+	require.Equal(t, earned, report.EarnedFees)
+	require.False(t, report.TaxWithheld.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)), report.EarnedFees.Add(report.TaxWithheld...))
+
+	// a request left unanswered is slashed once it expires
+	callService(ctx)
+	am.EndBlock(ctx.WithBlockHeight(ctx.BlockHeight()+10), abci.RequestEndBlock{})
+
+	report, found = app.payoutKeeper.GetReport(ctx, provider, epoch)
+	require.True(t, found)
+	require.Equal(t, uint64(1), report.Responses)
+	require.Equal(t, uint64(1), report.Slashes)
+	require.False(t, report.Slashed.IsZero())
+
+	binding, _ := app.serviceKeeper.GetServiceBinding(ctx, "payout-test", provider)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100_000_000)).Sub(report.Slashed), binding.Deposit)
+}
@@ -0,0 +1,601 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cast"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+)
+
+const (
+	// FlagAPIServiceWebhooks enables the registration of webhooks for service responses in the API server
+	FlagAPIServiceWebhooks = "api.service-webhooks"
+	// FlagAPIServiceWebhooksAllowPrivate allows webhooks on loopback, link-local and private addresses
+	FlagAPIServiceWebhooksAllowPrivate = "api.service-webhooks-allow-private"
+
+	// ServiceWebhookSignDomain prefixes the bytes a consumer signs to register a webhook,
+	// so that the signature can't be mistaken for a signature of another protocol
+	ServiceWebhookSignDomain = "irishub-service-webhook/v1"
+
+	serviceWebhookSubscriber = "service-webhooks"
+
+	// the maximum number of webhooks registered for a request context and for
+	// all the request contexts of a consumer
+	serviceWebhooksPerContext  = 10
+	serviceWebhooksPerConsumer = 100
+
+	// the responses are delivered by a fixed pool of workers, the responses
+	// arriving while the queue is full are dropped
+	serviceWebhookWorkers   = 8
+	serviceWebhookQueueSize = 256
+
+	// the length in bytes of the secret returned on registration, which is
+	// required to unregister the webhook
+	serviceWebhookSecretLen = 16
+
+	// the number of attempts made to load the proof of a response tx, which
+	// is only indexed once its block has been committed
+	serviceWebhookProofAttempts = 10
+	serviceWebhookProofInterval = time.Second
+
+	serviceWebhookTimeout = 10 * time.Second
+)
+
+// privateNetworks are the networks not routable on the internet, in
+// addition to the loopback, link-local and multicast ones
+var privateNetworks = mustParseCIDRs(
+	"0.0.0.0/8",      // "this" network
+	"10.0.0.0/8",     // RFC1918
+	"100.64.0.0/10",  // RFC6598 shared address space
+	"172.16.0.0/12",  // RFC1918
+	"192.168.0.0/16", // RFC1918
+	"fc00::/7",       // RFC4193 unique local addresses
+)
+
+// ServiceWebhookRequest is the body of the requests registering and unregistering webhooks.
+// A registration is signed by the consumer of the request context over the
+// ServiceWebhookSignBytes of the request context, the url and a nonce greater
+// than the ones of its previous registrations. The secret is returned on
+// registration and required to unregister.
+type ServiceWebhookRequest struct {
+	RequestContextID string `json:"request_context_id" yaml:"request_context_id"`
+	URL              string `json:"url" yaml:"url"`
+	Nonce            uint64 `json:"nonce,omitempty" yaml:"nonce,omitempty"`
+	// bech32 encoded account public key of the consumer
+	PubKey string `json:"pub_key,omitempty" yaml:"pub_key,omitempty"`
+	// base64 encoded signature
+	Signature string `json:"signature,omitempty" yaml:"signature,omitempty"`
+	Secret    string `json:"secret,omitempty" yaml:"secret,omitempty"`
+}
+
+// ServiceWebhookPayload is the body posted to a webhook for every response to its request context
+type ServiceWebhookPayload struct {
+	RequestContextID string          `json:"request_context_id"`
+	RequestID        string          `json:"request_id"`
+	Height           int64           `json:"height"`
+	TxHash           string          `json:"tx_hash"`
+	Response         json.RawMessage `json:"response"`
+	// proof of inclusion of the response tx in the data hash of the block header at Height
+	Proof tmtypes.TxProof `json:"proof"`
+}
+
+// ServiceWebhooks posts the responses to service requests to the webhooks
+// registered for their request context. Webhooks are registered with the API
+// server only, they are not part of the consensus state and are lost when
+// the node restarts.
+//
+// Only the consumer of a request context can register webhooks for it, by
+// signing the registration with its account key, and the number of webhooks
+// is capped per request context and per consumer.
+//
+// Unless allowPrivate is set, webhooks may only be reached on public
+// addresses: the check is made on registration for literal hosts and on
+// every connection for the resolved ones.
+type ServiceWebhooks struct {
+	logger       log.Logger
+	httpClient   *http.Client
+	allowPrivate bool
+	jobs         chan serviceWebhookJob
+
+	// queryConsumer returns the consumer of a request context
+	queryConsumer func(ctx context.Context, clientCtx client.Context, requestContextID string) (string, error)
+
+	mtx    sync.RWMutex
+	hooks  map[string]map[string]serviceWebhook // request context ID -> webhook URL -> webhook
+	counts map[string]int                       // consumer -> number of webhooks
+	nonces map[string]uint64                    // consumer -> nonce of the last registration
+}
+
+// serviceWebhook is a webhook registered by the consumer of a request context
+type serviceWebhook struct {
+	consumer string
+	secret   string
+}
+
+// serviceWebhookJob is a response waiting to be delivered to the webhooks
+type serviceWebhookJob struct {
+	height           int64
+	txHash           []byte
+	requestContextID string
+	requestID        string
+	urls             []string
+}
+
+// NewServiceWebhooks creates an empty ServiceWebhooks
+func NewServiceWebhooks(logger log.Logger, allowPrivate bool) *ServiceWebhooks {
+	dialer := &net.Dialer{Timeout: serviceWebhookTimeout}
+	if !allowPrivate {
+		dialer.Control = publicDialControl
+	}
+
+	return &ServiceWebhooks{
+		logger: logger.With("module", serviceWebhookSubscriber),
+		httpClient: &http.Client{
+			Timeout:   serviceWebhookTimeout,
+			Transport: &http.Transport{DialContext: dialer.DialContext},
+		},
+		allowPrivate:  allowPrivate,
+		jobs:          make(chan serviceWebhookJob, serviceWebhookQueueSize),
+		queryConsumer: queryRequestContextConsumer,
+		hooks:         make(map[string]map[string]serviceWebhook),
+		counts:        make(map[string]int),
+		nonces:        make(map[string]uint64),
+	}
+}
+
+// loadServiceWebhooks returns the webhooks registry if enabled in the app options, otherwise nil.
+func loadServiceWebhooks(logger log.Logger, appOpts servertypes.AppOptions) *ServiceWebhooks {
+	if !cast.ToBool(appOpts.Get(FlagAPIServiceWebhooks)) {
+		return nil
+	}
+	return NewServiceWebhooks(logger, cast.ToBool(appOpts.Get(FlagAPIServiceWebhooksAllowPrivate)))
+}
+
+// Register adds a webhook for the responses to the given request context of
+// the given consumer, once the signature of the request by the consumer is
+// verified, and returns the secret required to unregister it
+func (w *ServiceWebhooks) Register(consumer string, req ServiceWebhookRequest) (string, error) {
+	if err := validateServiceWebhook(req.RequestContextID, req.URL, w.allowPrivate); err != nil {
+		return "", err
+	}
+	if err := verifyServiceWebhookSignature(consumer, req); err != nil {
+		return "", err
+	}
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if req.Nonce <= w.nonces[consumer] {
+		return "", fmt.Errorf("nonce %d already used, it must be greater than %d", req.Nonce, w.nonces[consumer])
+	}
+
+	hooks := w.hooks[req.RequestContextID]
+	if _, ok := hooks[req.URL]; ok {
+		return "", fmt.Errorf("webhook %s already registered for request context %s", req.URL, req.RequestContextID)
+	}
+	if len(hooks) >= serviceWebhooksPerContext {
+		return "", fmt.Errorf("too many webhooks for request context %s, the maximum is %d", req.RequestContextID, serviceWebhooksPerContext)
+	}
+	if w.counts[consumer] >= serviceWebhooksPerConsumer {
+		return "", fmt.Errorf("too many webhooks for consumer %s, the maximum is %d", consumer, serviceWebhooksPerConsumer)
+	}
+
+	secret := make([]byte, serviceWebhookSecretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	if hooks == nil {
+		hooks = make(map[string]serviceWebhook)
+		w.hooks[req.RequestContextID] = hooks
+	}
+	hooks[req.URL] = serviceWebhook{consumer: consumer, secret: hex.EncodeToString(secret)}
+	w.counts[consumer]++
+	w.nonces[consumer] = req.Nonce
+	return hooks[req.URL].secret, nil
+}
+
+// Unregister removes a webhook of the given request context, given the secret returned on registration
+func (w *ServiceWebhooks) Unregister(requestContextID, webhookURL, secret string) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	hook, ok := w.hooks[requestContextID][webhookURL]
+	if !ok {
+		return fmt.Errorf("webhook %s not registered for request context %s", webhookURL, requestContextID)
+	}
+	if subtle.ConstantTimeCompare([]byte(hook.secret), []byte(secret)) != 1 {
+		return fmt.Errorf("invalid secret for webhook %s", webhookURL)
+	}
+
+	delete(w.hooks[requestContextID], webhookURL)
+	if len(w.hooks[requestContextID]) == 0 {
+		delete(w.hooks, requestContextID)
+	}
+	w.counts[hook.consumer]--
+	if w.counts[hook.consumer] == 0 {
+		delete(w.counts, hook.consumer)
+	}
+	return nil
+}
+
+// Webhooks returns the webhooks registered for the given request context
+func (w *ServiceWebhooks) Webhooks(requestContextID string) []string {
+	w.mtx.RLock()
+	defer w.mtx.RUnlock()
+
+	urls := make([]string, 0, len(w.hooks[requestContextID]))
+	for webhookURL := range w.hooks[requestContextID] {
+		urls = append(urls, webhookURL)
+	}
+	sort.Strings(urls)
+	return urls
+}
+
+// RegisterRoutes registers the REST routes managing the webhooks
+func (w *ServiceWebhooks) RegisterRoutes(clientCtx client.Context, r *mux.Router) {
+	r.HandleFunc("/service/webhooks", w.registerHandlerFn(clientCtx)).Methods("POST")
+	r.HandleFunc("/service/webhooks", w.unregisterHandlerFn(clientCtx)).Methods("DELETE")
+	r.HandleFunc("/service/webhooks/{request-context-id}", w.queryHandlerFn(clientCtx)).Methods("GET")
+}
+
+func (w *ServiceWebhooks) registerHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		var req ServiceWebhookRequest
+		if !rest.ReadRESTReq(rw, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		if err := validateServiceWebhook(req.RequestContextID, req.URL, w.allowPrivate); err != nil {
+			rest.WriteErrorResponse(rw, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		consumer, err := w.queryConsumer(r.Context(), clientCtx, req.RequestContextID)
+		if err != nil {
+			rest.WriteErrorResponse(rw, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		secret, err := w.Register(consumer, req)
+		if err != nil {
+			rest.WriteErrorResponse(rw, http.StatusForbidden, err.Error())
+			return
+		}
+
+		req.Secret = secret
+		rest.PostProcessResponseBare(rw, clientCtx, req)
+	}
+}
+
+func (w *ServiceWebhooks) unregisterHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		var req ServiceWebhookRequest
+		if !rest.ReadRESTReq(rw, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		if err := w.Unregister(req.RequestContextID, req.URL, req.Secret); err != nil {
+			rest.WriteErrorResponse(rw, http.StatusForbidden, err.Error())
+			return
+		}
+
+		req.Secret = ""
+		rest.PostProcessResponseBare(rw, clientCtx, req)
+	}
+}
+
+func (w *ServiceWebhooks) queryHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		requestContextID := mux.Vars(r)["request-context-id"]
+		rest.PostProcessResponseBare(rw, clientCtx, w.Webhooks(requestContextID))
+	}
+}
+
+// Start subscribes to the service responses committed by the node and posts
+// them to the registered webhooks until the context is done
+func (w *ServiceWebhooks) Start(ctx context.Context, clientCtx client.Context) error {
+	if clientCtx.Client == nil {
+		return fmt.Errorf("no node client to subscribe to the service responses")
+	}
+
+	query := fmt.Sprintf(
+		"%s='%s' AND %s.%s EXISTS",
+		tmtypes.EventTypeKey, tmtypes.EventTx,
+		servicetypes.EventTypeRespondService, servicetypes.AttributeKeyRequestContextID,
+	)
+	events, err := clientCtx.Client.Subscribe(ctx, serviceWebhookSubscriber, query)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < serviceWebhookWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-w.jobs:
+					w.deliver(ctx, clientCtx, job)
+				}
+			}
+		}()
+	}
+
+	go func() {
+		for event := range events {
+			data, ok := event.Data.(tmtypes.EventDataTx)
+			if !ok {
+				continue
+			}
+			w.handleTx(data)
+		}
+	}()
+
+	return nil
+}
+
+// handleTx queues the responses contained in a tx for delivery to the registered webhooks
+func (w *ServiceWebhooks) handleTx(data tmtypes.EventDataTx) {
+	for _, event := range data.Result.Events {
+		if event.Type != servicetypes.EventTypeRespondService {
+			continue
+		}
+
+		var requestContextID, requestID string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case servicetypes.AttributeKeyRequestContextID:
+				requestContextID = string(attr.Value)
+			case servicetypes.AttributeKeyRequestID:
+				requestID = string(attr.Value)
+			}
+		}
+
+		urls := w.Webhooks(requestContextID)
+		if len(urls) == 0 {
+			continue
+		}
+
+		job := serviceWebhookJob{
+			height:           data.Height,
+			txHash:           tmtypes.Tx(data.Tx).Hash(),
+			requestContextID: requestContextID,
+			requestID:        requestID,
+			urls:             urls,
+		}
+
+		select {
+		case w.jobs <- job:
+		default:
+			w.logger.Error(
+				"service webhook queue full, dropping the response",
+				"request_context_id", requestContextID, "request_id", requestID,
+			)
+		}
+	}
+}
+
+// deliver waits for the response tx to be committed, then posts the response
+// and the proof of the tx to the webhooks of the job
+func (w *ServiceWebhooks) deliver(ctx context.Context, clientCtx client.Context, job serviceWebhookJob) {
+	logger := w.logger.With("request_context_id", job.requestContextID, "request_id", job.requestID)
+
+	payload, err := w.buildPayload(ctx, clientCtx, job.height, job.txHash, job.requestContextID, job.requestID)
+	if err != nil {
+		logger.Error("failed to build the service webhook payload", "err", err)
+		return
+	}
+
+	bz, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error("failed to encode the service webhook payload", "err", err)
+		return
+	}
+
+	for _, webhookURL := range job.urls {
+		if err := w.post(ctx, webhookURL, bz); err != nil {
+			logger.Error("failed to post to the service webhook", "url", webhookURL, "err", err)
+		}
+	}
+}
+
+func (w *ServiceWebhooks) buildPayload(
+	ctx context.Context, clientCtx client.Context, height int64, txHash []byte,
+	requestContextID, requestID string,
+) (*ServiceWebhookPayload, error) {
+	var proof tmtypes.TxProof
+	for attempt := 1; ; attempt++ {
+		res, err := clientCtx.Client.Tx(ctx, txHash, true)
+		if err == nil {
+			proof = res.Proof
+			break
+		}
+		if attempt == serviceWebhookProofAttempts {
+			return nil, fmt.Errorf("failed to load the proof of tx %X: %w", txHash, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(serviceWebhookProofInterval):
+		}
+	}
+
+	queryClient := servicetypes.NewQueryClient(clientCtx.WithHeight(height))
+	res, err := queryClient.Response(ctx, &servicetypes.QueryResponseRequest{RequestId: requestID})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := clientCtx.JSONMarshaler.MarshalJSON(res.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceWebhookPayload{
+		RequestContextID: requestContextID,
+		RequestID:        requestID,
+		Height:           height,
+		TxHash:           fmt.Sprintf("%X", txHash),
+		Response:         response,
+		Proof:            proof,
+	}, nil
+}
+
+func (w *ServiceWebhooks) post(ctx context.Context, webhookURL string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return nil
+}
+
+// queryRequestContextConsumer queries the node for the consumer of a request context
+func queryRequestContextConsumer(ctx context.Context, clientCtx client.Context, requestContextID string) (string, error) {
+	res, err := servicetypes.NewQueryClient(clientCtx).RequestContext(
+		ctx, &servicetypes.QueryRequestContextRequest{RequestContextId: requestContextID},
+	)
+	if err != nil {
+		return "", err
+	}
+	if res.RequestContext == nil || len(res.RequestContext.Consumer) == 0 {
+		return "", fmt.Errorf("unknown request context %s", requestContextID)
+	}
+	return res.RequestContext.Consumer, nil
+}
+
+// ServiceWebhookSignBytes returns the bytes a consumer signs to register a
+// webhook: the ServiceWebhookSignDomain tag followed by the SHA-256 hash of
+// the request context ID, the url and the nonce separated by new lines.
+func ServiceWebhookSignBytes(requestContextID, webhookURL string, nonce uint64) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s\n%s\n%d", requestContextID, webhookURL, nonce)))
+	return append([]byte(ServiceWebhookSignDomain), hash[:]...)
+}
+
+// verifyServiceWebhookSignature checks that a webhook registration is signed
+// with the key of the given consumer
+func verifyServiceWebhookSignature(consumer string, req ServiceWebhookRequest) error {
+	consumerAddr, err := sdk.AccAddressFromBech32(consumer)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, req.PubKey)
+	if err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+	if !consumerAddr.Equals(sdk.AccAddress(pubKey.Address())) {
+		return fmt.Errorf("the public key is not the one of the consumer %s", consumer)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(req.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if !pubKey.VerifySignature(ServiceWebhookSignBytes(req.RequestContextID, req.URL, req.Nonce), sig) {
+		return fmt.Errorf("invalid signature of the consumer %s", consumer)
+	}
+	return nil
+}
+
+func validateServiceWebhook(requestContextID, webhookURL string, allowPrivate bool) error {
+	if _, err := hex.DecodeString(requestContextID); err != nil || len(requestContextID) == 0 {
+		return fmt.Errorf("invalid request context id: %s", requestContextID)
+	}
+
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return fmt.Errorf("invalid webhook url %s, an absolute http or https url is required", webhookURL)
+	}
+
+	if !allowPrivate {
+		host := strings.ToLower(u.Hostname())
+		if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+			return fmt.Errorf("invalid webhook url %s, the host is not public", webhookURL)
+		}
+		if ip := net.ParseIP(host); ip != nil && !isPublicIP(ip) {
+			return fmt.Errorf("invalid webhook url %s, the host is not public", webhookURL)
+		}
+	}
+	return nil
+}
+
+// publicDialControl refuses the connections to non-public addresses, which
+// also covers the hosts resolving to them and the redirects
+func publicDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("webhook address %s is not public", host)
+	}
+	return nil
+}
+
+// isPublicIP returns false for the loopback, link-local, multicast, unspecified and private addresses
+func isPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
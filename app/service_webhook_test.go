@@ -0,0 +1,291 @@
+package app
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+)
+
+// signedWebhookRequest returns a webhook registration signed with the given key
+func signedWebhookRequest(t *testing.T, privKey cryptotypes.PrivKey, requestContextID, webhookURL string, nonce uint64) ServiceWebhookRequest {
+	sig, err := privKey.Sign(ServiceWebhookSignBytes(requestContextID, webhookURL, nonce))
+	require.NoError(t, err)
+	return ServiceWebhookRequest{
+		RequestContextID: requestContextID,
+		URL:              webhookURL,
+		Nonce:            nonce,
+		PubKey:           sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, privKey.PubKey()),
+		Signature:        base64.StdEncoding.EncodeToString(sig),
+	}
+}
+
+func TestServiceWebhooksRegistry(t *testing.T) {
+	webhooks := NewServiceWebhooks(log.NewNopLogger(), false)
+	requestContextID := strings.Repeat("AB", 40)
+	privKey := secp256k1.GenPrivKey()
+	consumer := sdk.AccAddress(privKey.PubKey().Address()).String()
+
+	secretB, err := webhooks.Register(consumer, signedWebhookRequest(t, privKey, requestContextID, "https://example.com/b", 1))
+	require.NoError(t, err)
+	secretA, err := webhooks.Register(consumer, signedWebhookRequest(t, privKey, requestContextID, "http://example.com/a", 2))
+	require.NoError(t, err)
+	require.NotEqual(t, secretA, secretB)
+	_, err = webhooks.Register(consumer, signedWebhookRequest(t, privKey, requestContextID, "http://example.com/a", 3))
+	require.Error(t, err)
+	require.Equal(t, []string{"http://example.com/a", "https://example.com/b"}, webhooks.Webhooks(requestContextID))
+
+	_, err = webhooks.Register(consumer, signedWebhookRequest(t, privKey, "not-hex", "http://example.com/a", 4))
+	require.Error(t, err)
+	_, err = webhooks.Register(consumer, signedWebhookRequest(t, privKey, requestContextID, "ftp://example.com/a", 5))
+	require.Error(t, err)
+	_, err = webhooks.Register(consumer, signedWebhookRequest(t, privKey, requestContextID, "/relative", 6))
+	require.Error(t, err)
+
+	require.Error(t, webhooks.Unregister(requestContextID, "http://example.com/a", secretB))
+	require.Error(t, webhooks.Unregister(requestContextID, "http://example.com/a", ""))
+	require.Error(t, webhooks.Unregister(requestContextID, "http://example.com/c", secretA))
+	require.NoError(t, webhooks.Unregister(requestContextID, "http://example.com/a", secretA))
+	require.Equal(t, []string{"https://example.com/b"}, webhooks.Webhooks(requestContextID))
+
+	require.NoError(t, webhooks.Unregister(requestContextID, "https://example.com/b", secretB))
+	require.Empty(t, webhooks.Webhooks(requestContextID))
+	require.Empty(t, webhooks.counts)
+}
+
+func TestServiceWebhooksSignature(t *testing.T) {
+	webhooks := NewServiceWebhooks(log.NewNopLogger(), false)
+	requestContextID := strings.Repeat("AB", 40)
+	privKey := secp256k1.GenPrivKey()
+	consumer := sdk.AccAddress(privKey.PubKey().Address()).String()
+
+	// signed by another account
+	otherKey := secp256k1.GenPrivKey()
+	_, err := webhooks.Register(consumer, signedWebhookRequest(t, otherKey, requestContextID, "http://example.com/a", 1))
+	require.Error(t, err)
+
+	// the public key of the consumer with the signature of another account
+	req := signedWebhookRequest(t, otherKey, requestContextID, "http://example.com/a", 1)
+	req.PubKey = sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, privKey.PubKey())
+	_, err = webhooks.Register(consumer, req)
+	require.Error(t, err)
+
+	// signed for another url
+	req = signedWebhookRequest(t, privKey, requestContextID, "http://example.com/a", 1)
+	req.URL = "http://example.com/b"
+	_, err = webhooks.Register(consumer, req)
+	require.Error(t, err)
+
+	req = signedWebhookRequest(t, privKey, requestContextID, "http://example.com/a", 1)
+	req.Signature = ""
+	_, err = webhooks.Register(consumer, req)
+	require.Error(t, err)
+
+	_, err = webhooks.Register(consumer, signedWebhookRequest(t, privKey, requestContextID, "http://example.com/a", 1))
+	require.NoError(t, err)
+
+	// a registration can't be replayed, nor an older nonce used
+	_, err = webhooks.Register(consumer, signedWebhookRequest(t, privKey, requestContextID, "http://example.com/b", 1))
+	require.Error(t, err)
+	_, err = webhooks.Register(consumer, signedWebhookRequest(t, privKey, requestContextID, "http://example.com/b", 2))
+	require.NoError(t, err)
+}
+
+func TestServiceWebhooksPrivateHosts(t *testing.T) {
+	requestContextID := strings.Repeat("AB", 40)
+
+	privKey := secp256k1.GenPrivKey()
+	consumer := sdk.AccAddress(privKey.PubKey().Address()).String()
+
+	for _, webhookURL := range []string{
+		"http://localhost/hook",
+		"http://api.localhost/hook",
+		"http://127.0.0.1/hook",
+		"http://[::1]:8080/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://10.1.2.3/hook",
+		"http://172.16.0.1/hook",
+		"http://192.168.1.1/hook",
+		"http://100.64.0.1/hook",
+		"http://0.0.0.0/hook",
+		"http://[fd00::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+	} {
+		req := signedWebhookRequest(t, privKey, requestContextID, webhookURL, 1)
+		_, err := NewServiceWebhooks(log.NewNopLogger(), false).Register(consumer, req)
+		require.Error(t, err, webhookURL)
+
+		_, err = NewServiceWebhooks(log.NewNopLogger(), true).Register(consumer, req)
+		require.NoError(t, err, webhookURL)
+	}
+
+	_, err := NewServiceWebhooks(log.NewNopLogger(), false).Register(consumer, signedWebhookRequest(t, privKey, requestContextID, "http://8.8.8.8/hook", 1))
+	require.NoError(t, err)
+}
+
+func TestServiceWebhooksDialPrivate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// the addresses a host resolves to are checked again on every connection
+	err := NewServiceWebhooks(log.NewNopLogger(), false).post(context.Background(), server.URL, []byte("{}"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is not public")
+
+	require.NoError(t, NewServiceWebhooks(log.NewNopLogger(), true).post(context.Background(), server.URL, []byte("{}")))
+}
+
+func TestServiceWebhooksLimits(t *testing.T) {
+	webhooks := NewServiceWebhooks(log.NewNopLogger(), false)
+	privKey := secp256k1.GenPrivKey()
+	consumer := sdk.AccAddress(privKey.PubKey().Address()).String()
+
+	nonce := uint64(0)
+	register := func(privKey cryptotypes.PrivKey, requestContextID, webhookURL string) error {
+		nonce++
+		_, err := webhooks.Register(
+			sdk.AccAddress(privKey.PubKey().Address()).String(),
+			signedWebhookRequest(t, privKey, requestContextID, webhookURL, nonce),
+		)
+		return err
+	}
+
+	for i := 0; i < serviceWebhooksPerContext; i++ {
+		require.NoError(t, register(privKey, "AB", fmt.Sprintf("http://example.com/%d", i)))
+	}
+	require.Error(t, register(privKey, "AB", "http://example.com/extra"))
+
+	for i := serviceWebhooksPerContext; i < serviceWebhooksPerConsumer; i++ {
+		require.NoError(t, register(privKey, fmt.Sprintf("%04X", i/serviceWebhooksPerContext), fmt.Sprintf("http://example.com/%d", i)))
+	}
+	require.Equal(t, serviceWebhooksPerConsumer, webhooks.counts[consumer])
+	require.Error(t, register(privKey, "CD", "http://example.com/extra"))
+
+	// the webhooks of a consumer don't count against the others
+	require.NoError(t, register(secp256k1.GenPrivKey(), "CD", "http://example.com/extra"))
+}
+
+func TestServiceWebhooksQueue(t *testing.T) {
+	webhooks := NewServiceWebhooks(log.NewNopLogger(), false)
+	privKey := secp256k1.GenPrivKey()
+	_, err := webhooks.Register(
+		sdk.AccAddress(privKey.PubKey().Address()).String(),
+		signedWebhookRequest(t, privKey, "AB", "http://example.com/hook", 1),
+	)
+	require.NoError(t, err)
+
+	data := tmtypes.EventDataTx{TxResult: abci.TxResult{
+		Height: 10,
+		Result: abci.ResponseDeliverTx{Events: []abci.Event{
+			{
+				Type: servicetypes.EventTypeRespondService,
+				Attributes: []abci.EventAttribute{
+					{Key: []byte(servicetypes.AttributeKeyRequestContextID), Value: []byte("AB")},
+					{Key: []byte(servicetypes.AttributeKeyRequestID), Value: []byte("CD")},
+				},
+			},
+			{
+				Type: servicetypes.EventTypeRespondService,
+				Attributes: []abci.EventAttribute{
+					{Key: []byte(servicetypes.AttributeKeyRequestContextID), Value: []byte("EF")},
+				},
+			},
+		}},
+	}}
+
+	webhooks.handleTx(data)
+	require.Len(t, webhooks.jobs, 1)
+	job := <-webhooks.jobs
+	require.Equal(t, "AB", job.requestContextID)
+	require.Equal(t, "CD", job.requestID)
+	require.Equal(t, []string{"http://example.com/hook"}, job.urls)
+
+	// with no worker started, the responses beyond the queue size are dropped without blocking
+	for i := 0; i < serviceWebhookQueueSize+10; i++ {
+		webhooks.handleTx(data)
+	}
+	require.Len(t, webhooks.jobs, serviceWebhookQueueSize)
+}
+
+func TestServiceWebhooksRoutes(t *testing.T) {
+	webhooks := NewServiceWebhooks(log.NewNopLogger(), false)
+	clientCtx := client.Context{}.WithLegacyAmino(MakeEncodingConfig().Amino)
+	requestContextID := strings.Repeat("CD", 40)
+
+	privKey := secp256k1.GenPrivKey()
+	consumer := sdk.AccAddress(privKey.PubKey().Address()).String()
+	webhooks.queryConsumer = func(_ context.Context, _ client.Context, id string) (string, error) {
+		if id != requestContextID {
+			return "", fmt.Errorf("unknown request context %s", id)
+		}
+		return consumer, nil
+	}
+
+	router := mux.NewRouter()
+	webhooks.RegisterRoutes(clientCtx, router)
+
+	post := func(req ServiceWebhookRequest) *httptest.ResponseRecorder {
+		body := clientCtx.LegacyAmino.MustMarshalJSON(req)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/service/webhooks", strings.NewReader(string(body))))
+		return rec
+	}
+
+	// only the consumer of the request context can register
+	rec := post(signedWebhookRequest(t, secp256k1.GenPrivKey(), requestContextID, "http://example.com/hook", 1))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Empty(t, webhooks.Webhooks(requestContextID))
+
+	rec = post(signedWebhookRequest(t, privKey, requestContextID, "http://example.com/hook", 1))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, []string{"http://example.com/hook"}, webhooks.Webhooks(requestContextID))
+
+	var registered ServiceWebhookRequest
+	require.NoError(t, clientCtx.LegacyAmino.UnmarshalJSON(rec.Body.Bytes(), &registered))
+	require.NotEmpty(t, registered.Secret)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/service/webhooks/"+requestContextID, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "http://example.com/hook")
+	require.NotContains(t, rec.Body.String(), registered.Secret)
+
+	rec = post(signedWebhookRequest(t, privKey, "xyz", "http://example.com/hook", 2))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = post(signedWebhookRequest(t, privKey, strings.Repeat("EF", 40), "http://example.com/hook", 2))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = post(signedWebhookRequest(t, privKey, requestContextID, "http://127.0.0.1/hook", 2))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// removal requires the secret returned on registration
+	body := `{"request_context_id":"` + requestContextID + `","url":"http://example.com/hook"}`
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/service/webhooks", strings.NewReader(body)))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Len(t, webhooks.Webhooks(requestContextID), 1)
+
+	deleteBody := `{"request_context_id":"` + requestContextID + `","url":"http://example.com/hook","secret":"` + registered.Secret + `"}`
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/service/webhooks", strings.NewReader(deleteBody)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Empty(t, webhooks.Webhooks(requestContextID))
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	nfttypes "github.com/irisnet/irismod/modules/nft/types"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+// the maximum lengths in bytes of the user-supplied texts stored on chain,
+// on top of the limits enforced by the modules themselves
+const (
+	MaxLineTextLength      = 256
+	MaxMultilineTextLength = 10000
+)
+
+// textField is a user-supplied text of a message
+type textField struct {
+	name      string
+	text      string
+	multiline bool
+}
+
+// ValidateText returns an error if the given user-supplied text is too long or
+// contains characters which could inject markup into the pages displaying it
+// or alter how it is displayed: angle brackets, control characters other than
+// line breaks and tabs in multi-line texts, and bidirectional formatting
+// characters.
+func ValidateText(field, text string, multiline bool) error {
+	maxLength := MaxLineTextLength
+	if multiline {
+		maxLength = MaxMultilineTextLength
+	}
+	if len(text) > maxLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is longer than %d bytes", field, maxLength)
+	}
+
+	if !utf8.ValidString(text) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not valid UTF-8", field)
+	}
+
+	for _, r := range text {
+		switch {
+		case r == '<' || r == '>':
+		case multiline && (r == '\n' || r == '\r' || r == '\t'):
+			continue
+		case unicode.IsControl(r) || unicode.Is(unicode.Bidi_Control, r):
+		default:
+			continue
+		}
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s contains the forbidden character %U", field, r)
+	}
+	return nil
+}
+
+// msgTextFields returns the user-supplied texts of the given message which
+// are stored on chain and displayed by explorers and wallets
+func msgTextFields(msg sdk.Msg) []textField {
+	switch msg := msg.(type) {
+	case *govtypes.MsgSubmitProposal:
+		content := msg.GetContent()
+		if content == nil {
+			return nil
+		}
+		return []textField{
+			{"proposal title", content.GetTitle(), false},
+			{"proposal description", content.GetDescription(), true},
+		}
+	case *stakingtypes.MsgCreateValidator:
+		return validatorDescriptionFields(msg.Description)
+	case *stakingtypes.MsgEditValidator:
+		return validatorDescriptionFields(msg.Description)
+	case *tokentypes.MsgIssueToken:
+		return []textField{{"token name", msg.Name, false}}
+	case *tokentypes.MsgEditToken:
+		return []textField{{"token name", msg.Name, false}}
+	case *servicetypes.MsgDefineService:
+		fields := []textField{
+			{"service description", msg.Description, true},
+			{"service author description", msg.AuthorDescription, true},
+		}
+		for _, tag := range msg.Tags {
+			fields = append(fields, textField{"service tag", tag, false})
+		}
+		return fields
+	case *servicetypes.MsgBindService:
+		return []textField{{"service binding options", msg.Options, true}}
+	case *servicetypes.MsgUpdateServiceBinding:
+		return []textField{{"service binding options", msg.Options, true}}
+	case *nfttypes.MsgIssueDenom:
+		return []textField{{"denom name", msg.Name, false}}
+	case *nfttypes.MsgMintNFT:
+		return []textField{{"nft name", msg.Name, false}}
+	case *nfttypes.MsgEditNFT:
+		return []textField{{"nft name", msg.Name, false}}
+	case *nfttypes.MsgTransferNFT:
+		return []textField{{"nft name", msg.Name, false}}
+	default:
+		return nil
+	}
+}
+
+func validatorDescriptionFields(description stakingtypes.Description) []textField {
+	return []textField{
+		{"validator moniker", description.Moniker, false},
+		{"validator identity", description.Identity, false},
+		{"validator website", description.Website, false},
+		{"validator security contact", description.SecurityContact, false},
+		{"validator details", description.Details, true},
+	}
+}
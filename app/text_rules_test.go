@@ -0,0 +1,59 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+)
+
+func TestValidateText(t *testing.T) {
+	testCases := []struct {
+		msg       string
+		text      string
+		multiline bool
+		expPass   bool
+	}{
+		{"plain", "IRIS Hub validator #1 (fees: 5%)", false, true},
+		{"unicode", "愛麗絲 & Bob's node", false, true},
+		{"line breaks", "first line\nsecond line\r\n\tindented", true, true},
+		{"line break in a single line", "first line\nsecond line", false, false},
+		{"markup", `<script>alert("x")</script>`, true, false},
+		{"closing bracket", "a > b", false, false},
+		{"control character", "null\x00byte", true, false},
+		{"bidi override", "txt.\u202egpj", false, false},
+		{"invalid utf-8", "\xff\xfe", false, false},
+		{"line too long", strings.Repeat("a", MaxLineTextLength+1), false, false},
+		{"multiline at max length", strings.Repeat("a", MaxMultilineTextLength), true, true},
+		{"multiline too long", strings.Repeat("a", MaxMultilineTextLength+1), true, false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateText("field", tc.text, tc.multiline)
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestMsgTextFields(t *testing.T) {
+	proposal, err := govtypes.NewMsgSubmitProposal(govtypes.NewTextProposal("title", "description"), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []textField{
+		{"proposal title", "title", false},
+		{"proposal description", "description", true},
+	}, msgTextFields(proposal))
+
+	editValidator := &stakingtypes.MsgEditValidator{Description: stakingtypes.Description{Moniker: "<b>moniker</b>"}}
+	require.Contains(t, msgTextFields(editValidator), textField{"validator moniker", "<b>moniker</b>", false})
+
+	defineService := &servicetypes.MsgDefineService{Tags: []string{"oracle", "price"}}
+	require.Len(t, msgTextFields(defineService), 4)
+}
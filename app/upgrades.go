@@ -0,0 +1,71 @@
+package app
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/irisnet/irishub/modules/blacklist"
+	blacklisttypes "github.com/irisnet/irishub/modules/blacklist/types"
+	blocktimetypes "github.com/irisnet/irishub/modules/blocktime/types"
+	"github.com/irisnet/irishub/modules/dustsweep"
+	dustsweeptypes "github.com/irisnet/irishub/modules/dustsweep/types"
+	"github.com/irisnet/irishub/modules/faucet"
+	faucettypes "github.com/irisnet/irishub/modules/faucet/types"
+	"github.com/irisnet/irishub/modules/featuregate"
+	featuregatetypes "github.com/irisnet/irishub/modules/featuregate/types"
+	"github.com/irisnet/irishub/modules/govdeposit"
+	govdeposittypes "github.com/irisnet/irishub/modules/govdeposit/types"
+	"github.com/irisnet/irishub/modules/govquorum"
+	govquorumtypes "github.com/irisnet/irishub/modules/govquorum/types"
+	"github.com/irisnet/irishub/modules/paramhistory"
+	paramhistorytypes "github.com/irisnet/irishub/modules/paramhistory/types"
+	"github.com/irisnet/irishub/modules/payout"
+	payouttypes "github.com/irisnet/irishub/modules/payout/types"
+	"github.com/irisnet/irishub/modules/poolwhitelist"
+	poolwhitelisttypes "github.com/irisnet/irishub/modules/poolwhitelist/types"
+)
+
+// UpgradeName is the name of the software upgrade plan which adds the modules
+// introduced since v1.0 to a running chain
+const UpgradeName = "v1.1"
+
+// upgradeStoreKeys returns the keys of the stores the upgrade adds
+func upgradeStoreKeys() []string {
+	return []string{
+		paramhistorytypes.StoreKey, faucettypes.StoreKey, blocktimetypes.StoreKey, payouttypes.StoreKey,
+		govquorumtypes.StoreKey, dustsweeptypes.StoreKey,
+	}
+}
+
+// registerUpgradeHandlers registers the handler of the upgrade and, if the node
+// restarts at the upgrade height, mounts the added stores. It must be called
+// before the latest version is loaded.
+func (app *IrisApp) registerUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeName, app.upgradeHandler)
+
+	info, err := app.upgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(err)
+	}
+	if info.Name == UpgradeName && !app.upgradeKeeper.IsSkipHeight(info.Height) {
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(
+			info.Height, &storetypes.StoreUpgrades{Added: upgradeStoreKeys()},
+		))
+	}
+}
+
+// upgradeHandler initializes the state of the added modules with their default
+// genesis states, in the order of the init genesis. The blocktime and escrow
+// modules have no genesis state.
+func (app *IrisApp) upgradeHandler(ctx sdk.Context, _ upgradetypes.Plan) {
+	featuregate.InitGenesis(ctx, app.featureGateKeeper, *featuregatetypes.DefaultGenesisState())
+	paramhistory.InitGenesis(ctx, app.paramHistoryKeeper, *paramhistorytypes.DefaultGenesisState())
+	faucet.InitGenesis(ctx, app.faucetKeeper, *faucettypes.DefaultGenesisState())
+	blacklist.InitGenesis(ctx, app.blacklistKeeper, *blacklisttypes.DefaultGenesisState())
+	poolwhitelist.InitGenesis(ctx, app.poolWhitelistKeeper, *poolwhitelisttypes.DefaultGenesisState())
+	payout.InitGenesis(ctx, app.payoutKeeper, *payouttypes.DefaultGenesisState())
+	govdeposit.InitGenesis(ctx, app.govDepositKeeper, *govdeposittypes.DefaultGenesisState())
+	govquorum.InitGenesis(ctx, app.govQuorumKeeper, *govquorumtypes.DefaultGenesisState())
+	dustsweep.InitGenesis(ctx, app.dustSweepKeeper, *dustsweeptypes.DefaultGenesisState())
+}
@@ -0,0 +1,357 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+	tokentypes "github.com/irisnet/irismod/modules/token/types"
+)
+
+// DebugCmd returns the debug cobra Command, extending the SDK debug tools
+// with irishub specific helpers.
+func DebugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(
+		RequestContextIDCmd(),
+		RequestIDCmd(),
+		DecodeProtoCmd(),
+		DecodeAminoCmd(),
+		DecodeStoreKeyCmd(),
+		TraceSummaryCmd(),
+	)
+	return cmd
+}
+
+// RequestContextIDCmd returns a command to compute or split a request context ID.
+func RequestContextIDCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "request-context-id [tx-hash] [msg-index] | [request-context-id]",
+		Short: "Compute a request context ID from a tx hash and message index, or split one",
+		Long: fmt.Sprintf(`Compute the request context ID created by the service call at the given
+message index of a transaction, or split a request context ID into its parts.
+
+Example:
+$ %s debug request-context-id 0B2CE4A5DAEB8E6E3F0CB1C5B7A0E1D0B5E2F3F0A1B2C3D4E5F60718293A4B5C 0
+$ %s debug request-context-id 0B2CE4A5DAEB8E6E3F0CB1C5B7A0E1D0B5E2F3F0A1B2C3D4E5F60718293A4B5C0000000000000000
+`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				txHash, msgIndex, err := servicetypes.SplitRequestContextID(bz)
+				if err != nil {
+					return err
+				}
+
+				cmd.Printf("Tx hash: %s\n", txHash)
+				cmd.Printf("Msg index: %d\n", msgIndex)
+				return nil
+			}
+
+			msgIndex, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(servicetypes.GenerateRequestContextID(bz, msgIndex).String())
+			return nil
+		},
+	}
+}
+
+// RequestIDCmd returns a command to split a request ID into its parts.
+func RequestIDCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "request-id [request-id]",
+		Short: "Split a request ID into its request context ID, batch counter, height and index",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			requestID, err := servicetypes.ConvertRequestID(args[0])
+			if err != nil {
+				return err
+			}
+
+			contextID, batchCounter, height, index, err := servicetypes.SplitRequestID(requestID)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Request context ID: %s\n", contextID)
+			cmd.Printf("Batch counter: %d\n", batchCounter)
+			cmd.Printf("Request height: %d\n", height)
+			cmd.Printf("Batch request index: %d\n", index)
+			return nil
+		},
+	}
+}
+
+// DecodeProtoCmd returns a command to decode proto encoded bytes, such as a
+// store value, into JSON.
+func DecodeProtoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-proto [type-name] [hex-bytes]",
+		Short: "Decode hex encoded protobuf bytes of the given message type into JSON",
+		Long: fmt.Sprintf(`Decode hex encoded protobuf bytes, such as a value dumped from a module store,
+into JSON. The type name is the fully-qualified protobuf message name.
+
+Example:
+$ %s debug decode-proto irismod.service.Request 0a50...
+$ %s debug decode-proto google.protobuf.Any 0a1a2f69726973...
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc, ok := clientCtx.JSONMarshaler.(codec.Marshaler)
+			if !ok {
+				return fmt.Errorf("the client codec cannot decode protobuf bytes")
+			}
+
+			typ := proto.MessageType(strings.TrimPrefix(args[0], "/"))
+			if typ == nil {
+				return fmt.Errorf("unknown message type %s", args[0])
+			}
+
+			bz, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, ok := reflect.New(typ.Elem()).Interface().(codec.ProtoMarshaler)
+			if !ok {
+				return fmt.Errorf("%s is not a protobuf message", args[0])
+			}
+
+			if err := cdc.UnmarshalBinaryBare(bz, msg); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(msg)
+		},
+	}
+}
+
+// aminoInterfaces lists the interfaces whose registered concrete types
+// decode-amino tries, in order. Amino only decodes into a registered
+// interface, so each attempt starts from a fresh pointer.
+var aminoInterfaces = []func() interface{}{
+	func() interface{} { return new(sdk.Tx) },
+	func() interface{} { return new(sdk.Msg) },
+	func() interface{} { return new(govtypes.Content) },
+	func() interface{} { return new(authtypes.AccountI) },
+	func() interface{} { return new(cryptotypes.PubKey) },
+}
+
+// DecodeAminoCmd returns a command to decode amino encoded bytes into JSON.
+func DecodeAminoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-amino [hex-bytes]",
+		Short: "Decode hex encoded amino bytes of a registered type into JSON",
+		Long: fmt.Sprintf(`Decode hex encoded amino bytes, such as a legacy transaction, message,
+proposal content, account or public key, into JSON. The bytes must start with
+the prefix of a concrete type registered with the legacy amino codec.
+
+Example:
+$ %s debug decode-amino 282816a90a2ca8a3619a0a...
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			for _, newPtr := range aminoInterfaces {
+				ptr := newPtr()
+				if err := clientCtx.LegacyAmino.UnmarshalBinaryBare(bz, ptr); err != nil {
+					continue
+				}
+
+				out, err := clientCtx.LegacyAmino.MarshalJSONIndent(ptr, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(out))
+				return nil
+			}
+
+			return fmt.Errorf("the bytes do not decode into any registered amino type")
+		},
+	}
+}
+
+// storeRecord describes the records stored under a key prefix of a module store
+type storeRecord struct {
+	name  string
+	key   func(key []byte) ([]string, error) // returns the labelled key fields following the prefix
+	value func() codec.ProtoMarshaler        // returns the record type, nil if the value is raw bytes
+}
+
+// storeRecords lists the records decode-store-key knows, by store and key prefix.
+// The coinswap module has no store records: its pools are the bank balances of
+// the reserve pool accounts.
+var storeRecords = map[string]map[byte]storeRecord{
+	servicetypes.StoreKey: {
+		servicetypes.ServiceDefinitionKey[0]: {"service definition", stringKey("Service name"), func() codec.ProtoMarshaler { return &servicetypes.ServiceDefinition{} }},
+		servicetypes.ServiceBindingKey[0]:    {"service binding", stringsKey("Service name", "Provider"), func() codec.ProtoMarshaler { return &servicetypes.ServiceBinding{} }},
+		servicetypes.OwnerKey[0]:             {"provider owner", addressKey("Provider", ""), func() codec.ProtoMarshaler { return &gogotypes.BytesValue{} }},
+		servicetypes.PricingKey[0]:           {"pricing", stringsKey("Service name", "Provider"), func() codec.ProtoMarshaler { return &servicetypes.Pricing{} }},
+		servicetypes.WithdrawAddrKey[0]:      {"withdrawal address", addressKey("Owner", ""), nil},
+		servicetypes.RequestContextKey[0]:    {"request context", hexKey("Request context ID"), func() codec.ProtoMarshaler { return &servicetypes.RequestContext{} }},
+		servicetypes.RequestKey[0]:           {"request", hexKey("Request ID"), func() codec.ProtoMarshaler { return &servicetypes.Request{} }},
+		servicetypes.ResponseKey[0]:          {"response", hexKey("Request ID"), func() codec.ProtoMarshaler { return &servicetypes.Response{} }},
+		servicetypes.RequestVolumeKey[0]:     {"request volume", stringsKey("Consumer", "Service name", "Provider"), func() codec.ProtoMarshaler { return &gogotypes.UInt64Value{} }},
+		servicetypes.EarnedFeesKey[0]:        {"earned fees", addressKey("Provider", "Denom"), func() codec.ProtoMarshaler { return &sdk.Coin{} }},
+		servicetypes.OwnerEarnedFeesKey[0]:   {"owner earned fees", addressKey("Owner", "Denom"), func() codec.ProtoMarshaler { return &sdk.Coin{} }},
+	},
+	tokentypes.StoreKey: {
+		tokentypes.PrefixTokenForSymbol[0]:  {"token", stringKey("Symbol"), func() codec.ProtoMarshaler { return &tokentypes.Token{} }},
+		tokentypes.PrefixTokenForMinUint[0]: {"token symbol by min unit", stringKey("Min unit"), func() codec.ProtoMarshaler { return &gogotypes.StringValue{} }},
+		tokentypes.PrefixTokens[0]:          {"token symbol by owner", addressKey("Owner", "Symbol"), func() codec.ProtoMarshaler { return &gogotypes.StringValue{} }},
+		tokentypes.PeffixBurnTokenAmt[0]:    {"burnt amount", stringKey("Min unit"), func() codec.ProtoMarshaler { return &sdk.Coin{} }},
+	},
+}
+
+// DecodeStoreKeyCmd returns a command to pretty-print a module store key and,
+// optionally, the record stored under it.
+func DecodeStoreKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-store-key [store] [hex-key] [hex-value]",
+		Short: "Pretty-print a module store key and the record stored under it",
+		Long: fmt.Sprintf(`Pretty-print a key of the service or token store, such as one dumped from the
+application database, and decode the record stored under it when given.
+
+Example:
+$ %s debug decode-store-key service 13e4a5...
+$ %s debug decode-store-key token 01697269 0a04697269...
+`, version.AppName, version.AppName),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			records, ok := storeRecords[args[0]]
+			if !ok {
+				return fmt.Errorf("unknown store %s", args[0])
+			}
+
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+			if len(key) == 0 {
+				return fmt.Errorf("empty key")
+			}
+
+			record, ok := records[key[0]]
+			if !ok {
+				return fmt.Errorf("unknown key prefix 0x%02x in the %s store", key[0], args[0])
+			}
+
+			fields, err := record.key(key[1:])
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Record: %s\n", record.name)
+			for _, field := range fields {
+				cmd.Println(field)
+			}
+
+			if len(args) == 2 {
+				return nil
+			}
+
+			value, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			if record.value == nil {
+				cmd.Printf("Value: %s\n", sdk.AccAddress(value))
+				return nil
+			}
+
+			cdc, ok := clientCtx.JSONMarshaler.(codec.Marshaler)
+			if !ok {
+				return fmt.Errorf("the client codec cannot decode protobuf bytes")
+			}
+
+			msg := record.value()
+			if err := cdc.UnmarshalBinaryBare(value, msg); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(msg)
+		},
+	}
+}
+
+// stringKey returns a key decoder for a key made of a single string
+func stringKey(label string) func([]byte) ([]string, error) {
+	return func(key []byte) ([]string, error) {
+		return []string{fmt.Sprintf("%s: %s", label, key)}, nil
+	}
+}
+
+// stringsKey returns a key decoder for a key made of delimited strings
+func stringsKey(labels ...string) func([]byte) ([]string, error) {
+	return func(key []byte) ([]string, error) {
+		parts := strings.Split(string(key), string(servicetypes.Delimiter))
+		if len(parts) != len(labels) {
+			return nil, fmt.Errorf("expected %d key fields, got %d", len(labels), len(parts))
+		}
+
+		fields := make([]string, len(parts))
+		for i, part := range parts {
+			fields[i] = fmt.Sprintf("%s: %s", labels[i], part)
+		}
+		return fields, nil
+	}
+}
+
+// hexKey returns a key decoder for a key made of a single binary ID
+func hexKey(label string) func([]byte) ([]string, error) {
+	return func(key []byte) ([]string, error) {
+		return []string{fmt.Sprintf("%s: %s", label, tmbytes.HexBytes(key))}, nil
+	}
+}
+
+// addressKey returns a key decoder for a key made of an address, followed by a
+// string if suffix is not empty
+func addressKey(label, suffix string) func([]byte) ([]string, error) {
+	return func(key []byte) ([]string, error) {
+		if len(key) < sdk.AddrLen || (suffix == "" && len(key) != sdk.AddrLen) {
+			return nil, fmt.Errorf("invalid address key length %d", len(key))
+		}
+
+		fields := []string{fmt.Sprintf("%s: %s", label, sdk.AccAddress(key[:sdk.AddrLen]))}
+		if suffix != "" {
+			fields = append(fields, fmt.Sprintf("%s: %s", suffix, key[sdk.AddrLen:]))
+		}
+		return fields, nil
+	}
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/app"
+)
+
+// ExportDelegationsCmd returns the export-delegations cobra Command.
+func ExportDelegationsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-delegations",
+		Short: "Export the stake of every delegator with every validator to JSON",
+		Long: `Export the stake of every delegator with every validator from the local node
+database. Entries are ordered by delegator and validator address, so exports
+taken at the same height are identical on every node.
+
+The stake of a delegator with a validator is "bonded" plus "unbonding".
+"redelegating" reports the tokens redelegated to the validator whose
+redelegation has not matured yet; they are already part of "bonded" (or of
+"unbonding" once unbonded again) and must not be added to the stake.
+`,
+		Example: fmt.Sprintf(`$ %s export-delegations --height 1000000 > delegations.json
+$ jq '[.[] | select(.validator == "iva1...") | {delegator, stake: ((.bonded | tonumber) + (.unbonding | tonumber))}]' delegations.json
+`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			if _, err := os.Stat(config.GenesisFile()); os.IsNotExist(err) {
+				return err
+			}
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+
+			irisApp := app.NewIrisApp(
+				serverCtx.Logger, db, nil, false, map[int64]bool{}, "", uint(1),
+				app.MakeEncodingConfig(), serverCtx.Viper,
+			)
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			if height != -1 {
+				err = irisApp.LoadHeight(height)
+			} else {
+				err = irisApp.LoadLatestVersion()
+			}
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(irisApp.ExportDelegatorShares(), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(server.FlagHeight, -1, "Export from a particular height (-1 means latest height)")
+	return cmd
+}
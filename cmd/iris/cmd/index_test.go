@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func TestValidateIndexEvent(t *testing.T) {
+	testCases := []struct {
+		event   string
+		expPass bool
+	}{
+		{"message.sender", true},
+		{"transfer.recipient", true},
+		{"message", false},
+		{"message.", false},
+		{".sender", false},
+		{"message.sender.extra", false},
+	}
+
+	for _, tc := range testCases {
+		err := validateIndexEvent(tc.event)
+		if tc.expPass {
+			require.NoError(t, err, tc.event)
+		} else {
+			require.Error(t, err, tc.event)
+		}
+	}
+}
+
+func TestSetIndexEvents(t *testing.T) {
+	appConfigPath := filepath.Join(t.TempDir(), "app.toml")
+
+	appConfig := serverconfig.DefaultConfig()
+	appConfig.MinGasPrices = "0.2uiris"
+	serverconfig.WriteConfigFile(appConfigPath, appConfig)
+
+	readConfig := func() *serverconfig.Config {
+		v := viper.New()
+		v.SetConfigFile(appConfigPath)
+		require.NoError(t, v.ReadInConfig())
+		config, err := serverconfig.ParseConfig(v)
+		require.NoError(t, err)
+		return config
+	}
+
+	events := []string{"message.sender", "transfer.recipient"}
+	require.NoError(t, setIndexEvents(appConfigPath, events))
+
+	// the other settings are preserved
+	config := readConfig()
+	require.Equal(t, events, config.IndexEvents)
+	require.Equal(t, "0.2uiris", config.MinGasPrices)
+
+	require.Error(t, setIndexEvents(appConfigPath, []string{"message"}))
+	require.Equal(t, events, readConfig().IndexEvents)
+
+	require.NoError(t, setIndexEvents(appConfigPath, nil))
+	require.Empty(t, readConfig().IndexEvents)
+
+	require.Error(t, setIndexEvents(filepath.Join(t.TempDir(), "app.toml"), events))
+}
@@ -0,0 +1,256 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/app"
+)
+
+const (
+	flagReplayFromHeight = "from-height"
+	flagReplayToHeight   = "to-height"
+
+	// key of the commit info of a version in the application database, see store/rootmulti
+	commitInfoKeyFmt = "s/%d"
+)
+
+// storeDivergence is a module store whose hash differs from the committed one
+type storeDivergence struct {
+	name     string
+	hash     []byte // nil if the store failed to commit over the existing version
+	expected []byte
+}
+
+// ReplayCmd returns the replay cobra Command, which re-executes stored blocks
+// with the current binary to find the first block whose app hash differs.
+func ReplayCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replay stored blocks against the current binary and compare the app hashes",
+		Long: `Load the application state at the height before --from-height, re-execute the
+stored blocks up to --to-height with the current binary and compare the app
+hash of each block with the one committed in the header of the next block.
+Replaying stops at the first divergent height, and the module stores whose
+hashes differ from the ones committed by the node are listed when known.
+
+The node must be stopped, and the state at the height before --from-height
+must not have been pruned. The node's databases are only read: the state
+written by the replayed blocks is kept in memory, which therefore grows with
+the number of blocks replayed.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			if _, err := os.Stat(config.GenesisFile()); os.IsNotExist(err) {
+				return err
+			}
+
+			fromHeight, _ := cmd.Flags().GetInt64(flagReplayFromHeight)
+			toHeight, _ := cmd.Flags().GetInt64(flagReplayToHeight)
+
+			dataDir := filepath.Join(config.RootDir, "data")
+
+			blockStoreDB, err := sdk.NewLevelDB("blockstore", dataDir)
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := tmstore.NewBlockStore(blockStoreDB)
+
+			stateDB, err := sdk.NewLevelDB("state", dataDir)
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+			stateStore := sm.NewStore(stateDB)
+
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+
+			// the app hash of a block is committed in the header of the next one
+			if toHeight == 0 {
+				toHeight = blockStore.Height() - 1
+			}
+			if fromHeight <= state.InitialHeight || toHeight < fromHeight {
+				return fmt.Errorf(
+					"invalid height range [%d, %d], the range must start after the initial height %d",
+					fromHeight, toHeight, state.InitialHeight,
+				)
+			}
+			if toHeight >= blockStore.Height() {
+				return fmt.Errorf("block %d is required to verify the app hash of block %d, latest block is %d", toHeight+1, toHeight, blockStore.Height())
+			}
+
+			appDB, err := sdk.NewLevelDB("application", dataDir)
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			// commit the replayed blocks in memory, leaving the node's state untouched,
+			// and keep a handle on the multistore to inspect the hashes of the module stores
+			replayDB := newOverlayDB(appDB)
+			cms := store.NewCommitMultiStore(replayDB)
+			irisApp := app.NewIrisApp(
+				serverCtx.Logger, replayDB, nil, false, map[int64]bool{}, homeDir, uint(1),
+				app.MakeEncodingConfig(), serverCtx.Viper,
+				func(bApp *baseapp.BaseApp) { bApp.SetCMS(cms) },
+			)
+			if err := irisApp.LoadHeight(fromHeight - 1); err != nil {
+				return err
+			}
+
+			appConn := proxy.NewAppConnConsensus(abcicli.NewLocalClient(nil, irisApp))
+
+			// the arguments are valid from here on, a divergence needs no usage
+			cmd.SilenceUsage = true
+
+			for height := fromHeight; height <= toHeight; height++ {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("block %d not found", height)
+				}
+				expectedAppHash := blockStore.LoadBlockMeta(height + 1).Header.AppHash
+
+				// read the store hashes committed by the node
+				expected, err := loadCommitInfo(appDB, height)
+				if err != nil {
+					return err
+				}
+
+				appHash, divergences, err := replayBlock(irisApp, cms, height, expected, func() ([]byte, error) {
+					return sm.ExecCommitBlock(appConn, block, serverCtx.Logger, stateStore, state.InitialHeight)
+				})
+				if err != nil {
+					return err
+				}
+
+				if len(divergences) == 0 && bytes.Equal(appHash, expectedAppHash) {
+					cmd.Printf("height %d: app hash %X\n", height, appHash)
+					continue
+				}
+
+				if appHash == nil {
+					cmd.Printf("height %d: failed to commit, expected app hash %X\n", height, expectedAppHash)
+				} else {
+					cmd.Printf("height %d: app hash %X, expected %X\n", height, appHash, expectedAppHash)
+				}
+				for _, d := range divergences {
+					if d.hash == nil {
+						cmd.Printf("  store %s: diverged, expected %X\n", d.name, d.expected)
+						continue
+					}
+					cmd.Printf("  store %s: hash %X, expected %X\n", d.name, d.hash, d.expected)
+				}
+				if expected == nil {
+					cmd.Println("  the store hashes of this height were not committed by the node")
+				}
+				return fmt.Errorf("app hash diverged at height %d", height)
+			}
+
+			cmd.Printf("replayed blocks %d to %d, no divergence found\n", fromHeight, toHeight)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagReplayFromHeight, 0, "The first block to replay")
+	cmd.Flags().Int64(flagReplayToHeight, 0, "The last block to replay (0 means the block before the latest one)")
+	_ = cmd.MarkFlagRequired(flagReplayFromHeight)
+	return cmd
+}
+
+// replayBlock executes and commits a block, returning its app hash and the
+// stores whose hashes differ from the expected commit info, if known. A
+// store which diverges from a version the node already committed makes the
+// commit panic, in which case the stores are committed one by one to find
+// the divergent ones.
+func replayBlock(
+	irisApp *app.IrisApp, cms storetypes.CommitMultiStore, height int64,
+	expected *storetypes.CommitInfo, exec func() ([]byte, error),
+) (appHash []byte, divergences []storeDivergence, err error) {
+	panicked := func() (msg interface{}) {
+		defer func() { msg = recover() }()
+		appHash, err = exec()
+		return nil
+	}()
+	if err != nil {
+		return nil, nil, err
+	}
+	if expected == nil {
+		return appHash, nil, nil
+	}
+
+	for _, info := range expected.StoreInfos {
+		key := irisApp.GetKey(info.Name)
+		if key == nil {
+			continue
+		}
+		store := cms.GetCommitKVStore(key)
+
+		commitID := store.LastCommitID()
+		if panicked != nil && commitID.Version != height {
+			if msg := commitStore(store); msg != nil {
+				divergences = append(divergences, storeDivergence{name: info.Name, expected: info.CommitId.Hash})
+				continue
+			}
+			commitID = store.LastCommitID()
+		}
+
+		if !bytes.Equal(commitID.Hash, info.CommitId.Hash) {
+			divergences = append(divergences, storeDivergence{name: info.Name, hash: commitID.Hash, expected: info.CommitId.Hash})
+		}
+	}
+
+	if panicked != nil && len(divergences) == 0 {
+		return nil, nil, fmt.Errorf("failed to commit block %d: %v", height, panicked)
+	}
+	return appHash, divergences, nil
+}
+
+// commitStore commits a single store, returning the panic message if it fails
+func commitStore(store storetypes.CommitKVStore) (msg interface{}) {
+	defer func() { msg = recover() }()
+	store.Commit()
+	return nil
+}
+
+// loadCommitInfo returns the commit info of a version from the application
+// database, or nil if the version has not been committed
+func loadCommitInfo(db dbm.DB, version int64) (*storetypes.CommitInfo, error) {
+	bz, err := db.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, version)))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+
+	var info storetypes.CommitInfo
+	if err := info.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("failed to decode the commit info of version %d: %w", version, err)
+	}
+	return &info, nil
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ dbm.DB = (*overlayDB)(nil)
+
+// overlayDB is a database reading through to a parent database and keeping
+// all the writes in memory, so that the parent is never modified
+type overlayDB struct {
+	cache storetypes.KVStore
+}
+
+// newOverlayDB creates an overlayDB on top of the given database
+func newOverlayDB(parent dbm.DB) *overlayDB {
+	return &overlayDB{cache: cachekv.NewStore(dbadapter.Store{DB: parent})}
+}
+
+// Get implements DB
+func (db *overlayDB) Get(key []byte) ([]byte, error) {
+	return db.cache.Get(key), nil
+}
+
+// Has implements DB
+func (db *overlayDB) Has(key []byte) (bool, error) {
+	return db.cache.Has(key), nil
+}
+
+// Set implements DB
+func (db *overlayDB) Set(key, value []byte) error {
+	db.cache.Set(key, value)
+	return nil
+}
+
+// SetSync implements DB
+func (db *overlayDB) SetSync(key, value []byte) error {
+	return db.Set(key, value)
+}
+
+// Delete implements DB
+func (db *overlayDB) Delete(key []byte) error {
+	db.cache.Delete(key)
+	return nil
+}
+
+// DeleteSync implements DB
+func (db *overlayDB) DeleteSync(key []byte) error {
+	return db.Delete(key)
+}
+
+// Iterator implements DB
+func (db *overlayDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.cache.Iterator(start, end), nil
+}
+
+// ReverseIterator implements DB
+func (db *overlayDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.cache.ReverseIterator(start, end), nil
+}
+
+// Close implements DB, the parent database is left open
+func (db *overlayDB) Close() error {
+	return nil
+}
+
+// NewBatch implements DB
+func (db *overlayDB) NewBatch() dbm.Batch {
+	return &overlayBatch{db: db}
+}
+
+// Print implements DB
+func (db *overlayDB) Print() error {
+	return nil
+}
+
+// Stats implements DB
+func (db *overlayDB) Stats() map[string]string {
+	return map[string]string{"database.type": "overlayDB"}
+}
+
+// overlayBatch buffers the operations until written to the overlayDB
+type overlayBatch struct {
+	db  *overlayDB
+	ops []overlayOp
+}
+
+type overlayOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// Set implements Batch
+func (b *overlayBatch) Set(key, value []byte) error {
+	if b.db == nil {
+		return fmt.Errorf("batch has been written or closed")
+	}
+	b.ops = append(b.ops, overlayOp{key: key, value: value})
+	return nil
+}
+
+// Delete implements Batch
+func (b *overlayBatch) Delete(key []byte) error {
+	if b.db == nil {
+		return fmt.Errorf("batch has been written or closed")
+	}
+	b.ops = append(b.ops, overlayOp{key: key, delete: true})
+	return nil
+}
+
+// Write implements Batch
+func (b *overlayBatch) Write() error {
+	if b.db == nil {
+		return fmt.Errorf("batch has been written or closed")
+	}
+	for _, op := range b.ops {
+		if op.delete {
+			b.db.cache.Delete(op.key)
+			continue
+		}
+		b.db.cache.Set(op.key, op.value)
+	}
+	return b.Close()
+}
+
+// WriteSync implements Batch
+func (b *overlayBatch) WriteSync() error {
+	return b.Write()
+}
+
+// Close implements Batch
+func (b *overlayBatch) Close() error {
+	b.db, b.ops = nil, nil
+	return nil
+}
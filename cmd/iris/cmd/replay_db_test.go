@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestOverlayDB(t *testing.T) {
+	parent := dbm.NewMemDB()
+	require.NoError(t, parent.Set([]byte("a"), []byte("1")))
+	require.NoError(t, parent.Set([]byte("b"), []byte("2")))
+
+	db := newOverlayDB(parent)
+	require.NoError(t, db.Set([]byte("c"), []byte("3")))
+	require.NoError(t, db.Delete([]byte("a")))
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Set([]byte("b"), []byte("20")))
+	require.NoError(t, batch.Delete([]byte("c")))
+	require.NoError(t, batch.Set([]byte("d"), []byte("4")))
+	require.NoError(t, batch.Write())
+	require.Error(t, batch.Set([]byte("e"), []byte("5")))
+
+	value, err := db.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("20"), value)
+	has, err := db.Has([]byte("a"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	iter, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	var keys []string
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"b", "d"}, keys)
+
+	// the parent is never written
+	value, err = parent.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	value, err = parent.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+	has, err = parent.Has([]byte("d"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
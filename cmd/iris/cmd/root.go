@@ -14,7 +14,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -81,10 +80,12 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		DebugCmd(),
+		IndexEventsCmd(app.DefaultNodeHome),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createIrisappAndExport, addModuleInitFlags)
+	rootCmd.AddCommand(ExportDelegationsCmd(app.DefaultNodeHome), ReplayCmd(app.DefaultNodeHome))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
@@ -97,6 +98,10 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(rootCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(rootCmd)
+	rootCmd.Flags().Bool(app.FlagAPISignResponses, false, "Sign the API query responses with a dedicated key")
+	rootCmd.Flags().String(app.FlagAPISignResponsesKeyFile, app.DefaultResponseKeyFile, "The file of the key signing the API query responses, generated if missing")
+	rootCmd.Flags().Bool(app.FlagAPIServiceWebhooks, false, "Allow registering webhooks for service responses in the API server")
+	rootCmd.Flags().Bool(app.FlagAPIServiceWebhooksAllowPrivate, false, "Allow service webhooks on loopback, link-local and private addresses")
 }
 
 func queryCommand() *cobra.Command {
@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagTraceTx   = "tx"
+	flagTraceKeys = "keys"
+)
+
+// traceOperation mirrors a single line written by the store tracer enabled
+// with the --trace-store flag of the start command.
+type traceOperation struct {
+	Operation string                 `json:"operation"`
+	Key       string                 `json:"key"`
+	Value     string                 `json:"value"`
+	Metadata  map[string]interface{} `json:"metadata"`
+}
+
+// txAccess is the read/write set of a single transaction
+type txAccess struct {
+	height int64
+	hash   string
+	reads  map[string]int
+	writes map[string]int
+}
+
+func newTxAccess(height int64, hash string) *txAccess {
+	return &txAccess{
+		height: height,
+		hash:   hash,
+		reads:  make(map[string]int),
+		writes: make(map[string]int),
+	}
+}
+
+// TraceSummaryCmd returns a command to summarize the per-transaction store
+// read/write sets recorded in a store trace file.
+func TraceSummaryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-summary [trace-file]",
+		Short: "Summarize the store read/write set of each transaction in a store trace file",
+		Long: fmt.Sprintf(`Summarize the store read/write set of each transaction recorded in a trace
+file written by a node started with --trace-store. For every transaction the
+number and size of the keys read and written are printed, followed by the keys
+of each block that were written by one transaction and accessed by another.
+
+Example:
+$ %s start --trace-store ~/.iris/trace.log
+$ %s debug trace-summary ~/.iris/trace.log --keys
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txFilter, err := cmd.Flags().GetString(flagTraceTx)
+			if err != nil {
+				return err
+			}
+			showKeys, err := cmd.Flags().GetBool(flagTraceKeys)
+			if err != nil {
+				return err
+			}
+
+			txs, err := readTraceFile(args[0], strings.ToUpper(txFilter))
+			if err != nil {
+				return err
+			}
+
+			for _, tx := range txs {
+				readBytes, writeBytes := 0, 0
+				for _, size := range tx.reads {
+					readBytes += size
+				}
+				for _, size := range tx.writes {
+					writeBytes += size
+				}
+
+				cmd.Printf(
+					"height %d tx %s: reads %d (%s), writes %d (%s)\n",
+					tx.height, tx.hash, len(tx.reads), formatBytes(int64(readBytes)),
+					len(tx.writes), formatBytes(int64(writeBytes)),
+				)
+
+				if showKeys {
+					for _, key := range sortedKeys(tx.reads) {
+						cmd.Printf("  read  %s %s\n", key, formatBytes(int64(tx.reads[key])))
+					}
+					for _, key := range sortedKeys(tx.writes) {
+						cmd.Printf("  write %s %s\n", key, formatBytes(int64(tx.writes[key])))
+					}
+				}
+			}
+
+			for _, conflict := range findConflicts(txs) {
+				cmd.Printf("height %d: key %s is accessed by %d txs\n", conflict.height, conflict.key, conflict.txs)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTraceTx, "", "Only summarize the transaction with the given hash")
+	cmd.Flags().Bool(flagTraceKeys, false, "List every key read or written by each transaction")
+	return cmd
+}
+
+// readTraceFile collects the read/write sets of the transactions in the
+// trace file, in the order they were executed. Operations recorded outside
+// of a transaction, e.g. in BeginBlock or EndBlock, are skipped.
+func readTraceFile(path, txFilter string) ([]*txAccess, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var txs []*txAccess
+	index := make(map[string]*txAccess)
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		var op traceOperation
+		if err := json.Unmarshal(scanner.Bytes(), &op); err != nil {
+			return nil, fmt.Errorf("invalid trace operation at line %d: %w", line, err)
+		}
+
+		hash, _ := op.Metadata["txHash"].(string)
+		if len(hash) == 0 || (len(txFilter) > 0 && hash != txFilter) {
+			continue
+		}
+
+		// the tracer encodes the height as a JSON number
+		heightValue, _ := op.Metadata["blockHeight"].(float64)
+		height := int64(heightValue)
+
+		id := fmt.Sprintf("%d/%s", height, hash)
+		tx, ok := index[id]
+		if !ok {
+			tx = newTxAccess(height, hash)
+			index[id] = tx
+			txs = append(txs, tx)
+		}
+
+		keyBz, err := base64.StdEncoding.DecodeString(op.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key at line %d: %w", line, err)
+		}
+		value, err := base64.StdEncoding.DecodeString(op.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value at line %d: %w", line, err)
+		}
+
+		key := fmt.Sprintf("%X", keyBz)
+		switch op.Operation {
+		case "read", "iterKey", "iterValue":
+			if len(value) > tx.reads[key] {
+				tx.reads[key] = len(value)
+			}
+		case "write":
+			tx.writes[key] = len(value)
+		case "delete":
+			tx.writes[key] = 0
+		}
+	}
+
+	return txs, scanner.Err()
+}
+
+type traceConflict struct {
+	height int64
+	key    string
+	txs    int
+}
+
+// findConflicts returns the keys written by a transaction and read or
+// written by at least one other transaction of the same block.
+func findConflicts(txs []*txAccess) []traceConflict {
+	type blockKey struct {
+		height int64
+		key    string
+	}
+
+	written := make(map[blockKey]bool)
+	accessed := make(map[blockKey]int)
+
+	for _, tx := range txs {
+		keys := make(map[string]bool, len(tx.reads)+len(tx.writes))
+		for key := range tx.reads {
+			keys[key] = true
+		}
+		for key := range tx.writes {
+			keys[key] = true
+			written[blockKey{tx.height, key}] = true
+		}
+		for key := range keys {
+			accessed[blockKey{tx.height, key}]++
+		}
+	}
+
+	var conflicts []traceConflict
+	for bk, count := range accessed {
+		if count > 1 && written[bk] {
+			conflicts = append(conflicts, traceConflict{height: bk.height, key: bk.key, txs: count})
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		if conflicts[i].height != conflicts[j].height {
+			return conflicts[i].height < conflicts[j].height
+		}
+		return conflicts[i].key < conflicts[j].key
+	})
+
+	return conflicts
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
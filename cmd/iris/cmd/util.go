@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -25,8 +24,6 @@ import (
 const (
 	formatJSON     = "json"
 	cmdScopeGlobal = "global"
-	filedTypeMap   = "map"
-	filedTypeArray = "array"
 )
 
 var (
@@ -40,16 +37,7 @@ var (
 			registerCmdWithArgs("staking", "redelegate", 2).
 			registerCmdWithArgs("staking", "unbond", 1).
 			registerCmdWithArgs("distribution", "fund-community-pool", 0).
-			registerCmdWithArgs("gov", "deposit", 1).
-			registerCmdForResponse("bank", "balances", "balances", filedTypeArray).
-			registerCmdForResponse("bank", "total", "supply", filedTypeArray).
-			registerCmdForResponse("gov", "params", "deposit_params.min_deposit", filedTypeArray).
-			registerCmdForResponse("distribution", "validator-outstanding-rewards", "rewards", filedTypeArray).
-			registerCmdForResponses("distribution", "rewards",
-			field{name: "total", typ: filedTypeArray},
-			field{name: "rewards.*.reward", typ: filedTypeArray},
-			field{name: "rewards", typ: filedTypeArray}).
-		registerCmdForResponse("token", "total-burn", "burned_coins", filedTypeArray)
+			registerCmdWithArgs("gov", "deposit", 1)
 
 	rescueStdout = os.Stdout
 )
@@ -58,7 +46,6 @@ type (
 	field struct {
 		name  string
 		index int
-		typ   string
 	}
 
 	command struct {
@@ -67,11 +54,10 @@ type (
 	}
 )
 
-func (c command) append(name, typ string, index int) command {
+func (c command) append(name string, index int) command {
 	c.fields[name] = field{
 		name:  name,
 		index: index,
-		typ:   typ,
 	}
 	return c
 }
@@ -102,7 +88,7 @@ func (it *coinConverter) registerCmdWithArgs(parentCmd, cmd string, argsIdx int)
 			fields:    map[string]field{},
 		}
 	}
-	commands = commands.append("ARGS", "", argsIdx)
+	commands = commands.append("ARGS", argsIdx)
 	it.cmds[cmd] = commands
 	return it
 }
@@ -115,7 +101,7 @@ func (it *coinConverter) registerGlobalFlag(flagNm string) *coinConverter {
 			fields:    map[string]field{},
 		}
 	}
-	commands = commands.append(flagNm, "", -1)
+	commands = commands.append(flagNm, -1)
 	it.cmds[cmdScopeGlobal] = commands
 	return it
 }
@@ -128,39 +114,7 @@ func (it *coinConverter) registerCmdWithFlag(parentCmd, cmd, flagNm string) *coi
 			fields:    map[string]field{},
 		}
 	}
-	commands = commands.append(flagNm, "", -1)
-	it.cmds[cmd] = commands
-	return it
-}
-
-func (it *coinConverter) registerCmdForResponse(parentCmd, cmd, jsonPath, typ string) *coinConverter {
-	commands, ok := it.cmds[cmd]
-	if !ok {
-		commands = command{
-			parentCmd: parentCmd,
-			fields:    map[string]field{},
-		}
-	}
-
-	commands = commands.append(jsonPath, typ, -1)
-
-	it.cmds[cmd] = commands
-	return it
-}
-
-func (it *coinConverter) registerCmdForResponses(parentCmd, cmd string, fields ...field) *coinConverter {
-	commands, ok := it.cmds[cmd]
-	if !ok {
-		commands = command{
-			parentCmd: parentCmd,
-			fields:    map[string]field{},
-		}
-	}
-
-	for _, field := range fields {
-		commands = commands.append(field.name, field.typ, -1)
-	}
-
+	commands = commands.append(flagNm, -1)
 	it.cmds[cmd] = commands
 	return it
 }
@@ -188,14 +142,6 @@ func (it coinConverter) getFromArgs(cmdNm string) (field, bool) {
 	return cmd.fields["ARGS"], true
 }
 
-func (it coinConverter) getFields(cmdNm string) map[string]field {
-	cmd, ok := it.cmds[cmdNm]
-	if !ok {
-		return map[string]field{}
-	}
-	return cmd.fields
-}
-
 func (it *coinConverter) handlePreRun(cmd *cobra.Command, args []string) {
 	if b, _ := cmd.Flags().GetBool(flags.FlagGenerateOnly); b {
 		return
@@ -248,54 +194,111 @@ func (it coinConverter) parseArgs(cmd *cobra.Command, args []string) {
 	}
 }
 
-func (it coinConverter) parseYAML(cmd *cobra.Command, in []byte) string {
+// parseYAML renders every coin of a query response in the text output as its
+// main unit amount followed by the min unit coin, e.g. "1,234.56 IRIS (1234560000uiris)"
+func (it *coinConverter) parseYAML(cmd *cobra.Command, in []byte) string {
 	cfg, err := config.ParseYamlBytes(in)
 	if err != nil {
 		return string(in)
 	}
 
-	fields := it.getFields(cmd.Name())
-	for path, field := range fields {
-		for _, p := range it.resolvePath(cfg, path) {
-			switch field.typ {
-			case filedTypeArray:
-				it.handleList(cmd, cfg, p)
-			case filedTypeMap:
-				it.handleMap(cmd, cfg, p)
-			}
-		}
-	}
-	s, err := config.RenderYaml(cfg.Root)
+	s, err := config.RenderYaml(it.formatCoins(cmd, cfg.Root))
 	if err != nil {
 		return string(in)
 	}
 	return s
 }
 
-func (it coinConverter) resolvePath(cfg *config.Config, path string) (paths []string) {
-	subPaths := strings.SplitN(path, "*", 2)
-	if len(subPaths) == 1 {
-		return []string{path}
+// formatCoins replaces the coins found in the given YAML node with their formatted text
+func (it *coinConverter) formatCoins(cmd *cobra.Command, node interface{}) interface{} {
+	switch node := node.(type) {
+	case map[string]interface{}:
+		if formatted, ok := it.formatCoin(cmd, node); ok {
+			return formatted
+		}
+		for key, value := range node {
+			node[key] = it.formatCoins(cmd, value)
+		}
+	case []interface{}:
+		for i, value := range node {
+			node[i] = it.formatCoins(cmd, value)
+		}
 	}
+	return node
+}
 
-	if len(subPaths) != 2 {
-		return paths
+// formatCoin returns the formatted text of a node holding exactly the denom
+// and amount of a coin of a known token
+func (it *coinConverter) formatCoin(cmd *cobra.Command, node map[string]interface{}) (string, bool) {
+	if len(node) != 2 {
+		return "", false
+	}
+	denom, ok := node["denom"].(string)
+	if !ok {
+		return "", false
+	}
+	amount, ok := node["amount"].(string)
+	if !ok {
+		return "", false
 	}
 
-	list, err := cfg.List(subPaths[0][:len(subPaths[0])-1])
+	srcCoin, err := sdk.ParseDecCoin(amount + denom)
 	if err != nil {
-		return paths
+		return "", false
 	}
 
-	for i := 0; i < len(list); i++ {
-		p := fmt.Sprintf("%s%d%s", subPaths[0], i, subPaths[1])
-		paths = append(paths, it.resolvePath(cfg, p)...)
+	ft, err := it.queryToken(cmd, denom)
+	if err != nil {
+		return "", false
 	}
-	return paths
+
+	truncCoin, _ := srcCoin.TruncateDecimal()
+	mainCoin, err := ft.ToMainCoin(truncCoin)
+	if err != nil {
+		return "", false
+	}
+	minCoin, err := ft.ToMinCoin(mainCoin)
+	if err != nil {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s %s (%s)", formatDecimal(mainCoin.Amount), strings.ToUpper(mainCoin.Denom), minCoin), true
+}
+
+// formatDecimal renders a decimal with comma-grouped thousands and without
+// trailing zeros, independently of the locale, e.g. "1,234.56"
+func formatDecimal(d sdk.Dec) string {
+	str := d.String()
+
+	sign := ""
+	if strings.HasPrefix(str, "-") {
+		sign, str = "-", str[1:]
+	}
+
+	integer, fraction := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		integer, fraction = str[:i], strings.TrimRight(str[i+1:], "0")
+	}
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	if len(fraction) == 0 {
+		return sign + grouped.String()
+	}
+	return sign + grouped.String() + "." + fraction
 }
 
 func (it *coinConverter) queryToken(cmd *cobra.Command, denom string) (ft tokentypes.TokenI, err error) {
 	if ft, ok := it.tokens[denom]; ok {
+		if ft == nil {
+			return nil, fmt.Errorf("unknown token: %s", denom)
+		}
 		return ft, nil
 	}
 
@@ -314,6 +317,8 @@ func (it *coinConverter) queryToken(cmd *cobra.Command, denom string) (ft tokent
 		Denom: denom,
 	})
 	if err != nil {
+		// remember the denoms which are not tokens, they may appear many times in a response
+		it.tokens[denom] = nil
 		return nil, err
 	}
 
@@ -340,40 +345,6 @@ func (it *coinConverter) isOutputYAML(cmd *cobra.Command) bool {
 	return true
 }
 
-func (it *coinConverter) handleList(cmd *cobra.Command, cfg *config.Config, path string) {
-	list, err := cfg.List(path)
-	if err != nil {
-		return
-	}
-	for i := range list {
-		it.handleMap(cmd, cfg, fmt.Sprintf("%s.%d", path, i))
-	}
-}
-
-func (it *coinConverter) handleMap(cmd *cobra.Command, cfg *config.Config, path string) {
-	cMap, err := cfg.Map(path)
-	if err != nil {
-		return
-	}
-
-	bz, err := json.Marshal(cMap)
-	if err != nil {
-		return
-	}
-
-	var srcCoin sdk.DecCoin
-	if err := json.Unmarshal(bz, &srcCoin); err != nil || !srcCoin.IsValid() {
-		return
-	}
-
-	truncCoin, _ := srcCoin.TruncateDecimal()
-	dstCoin, err := it.convertToMainCoin(cmd, truncCoin)
-	if err != nil {
-		return
-	}
-	_ = cfg.Set(path, dstCoin)
-}
-
 func (it *coinConverter) convertCoins(cmd *cobra.Command, coinsStr string) (dstCoinsStr string, err error) {
 	cs, err := it.parseCoins(coinsStr)
 	if err != nil {
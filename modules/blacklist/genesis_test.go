@@ -0,0 +1,49 @@
+package blacklist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/blacklist"
+	"github.com/irisnet/irishub/modules/blacklist/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) TestExportGenesis() {
+	defaultGenesis := types.DefaultGenesisState()
+	exportedGenesis := blacklist.ExportGenesis(suite.ctx, suite.app.BlacklistKeeper)
+	suite.Equal(defaultGenesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestInitGenesis() {
+	genesis := types.NewGenesisState(
+		types.NewParams([]string{"scam"}),
+	)
+	blacklist.InitGenesis(suite.ctx, suite.app.BlacklistKeeper, *genesis)
+
+	exportedGenesis := blacklist.ExportGenesis(suite.ctx, suite.app.BlacklistKeeper)
+	suite.Equal(genesis, exportedGenesis)
+}
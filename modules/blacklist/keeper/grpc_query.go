@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/blacklist/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the blacklist parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Denom queries whether a denom is blacklisted
+func (k Keeper) Denom(c context.Context, req *types.QueryDenomRequest) (*types.QueryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryDenomResponse{Denom: req.Denom, Blacklisted: k.IsBlacklisted(ctx, req.Denom)}, nil
+}
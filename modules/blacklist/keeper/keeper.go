@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/blacklist/types"
+)
+
+// Keeper of the blacklist store
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper returns a blacklist keeper
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	return Keeper{
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GetParamSet returns blacklist params from the global param store.
+// The denoms may be absent on chains that added the module by an upgrade,
+// in which case no denom is blacklisted.
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyDenoms, &params.Denoms)
+	return params
+}
+
+// SetParamSet sets blacklist params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsBlacklisted returns true if the given denom has been blacklisted by governance
+func (k Keeper) IsBlacklisted(ctx sdk.Context, denom string) bool {
+	return k.GetParamSet(ctx).IsBlacklisted(denom)
+}
+
+// ValidateCoins returns an error if any of the given coins is blacklisted
+func (k Keeper) ValidateCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	params := k.GetParamSet(ctx)
+	for _, coin := range coins {
+		if params.IsBlacklisted(coin.Denom) {
+			return sdkerrors.Wrap(types.ErrBlacklistedDenom, coin.Denom)
+		}
+	}
+	return nil
+}
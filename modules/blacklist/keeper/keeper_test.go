@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/blacklist/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestSetGetParamSet() {
+	params := types.NewParams([]string{"scam"})
+	suite.app.BlacklistKeeper.SetParamSet(suite.ctx, params)
+	expParamSet := suite.app.BlacklistKeeper.GetParamSet(suite.ctx)
+
+	require.Equal(suite.T(), params, expParamSet)
+}
+
+func (suite *KeeperTestSuite) TestValidateCoins() {
+	suite.app.BlacklistKeeper.SetParamSet(suite.ctx, types.NewParams([]string{"scam"}))
+
+	require.True(suite.T(), suite.app.BlacklistKeeper.IsBlacklisted(suite.ctx, "scam"))
+	require.False(suite.T(), suite.app.BlacklistKeeper.IsBlacklisted(suite.ctx, sdk.DefaultBondDenom))
+
+	require.NoError(suite.T(), suite.app.BlacklistKeeper.ValidateCoins(suite.ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	require.ErrorIs(
+		suite.T(),
+		suite.app.BlacklistKeeper.ValidateCoins(suite.ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.NewInt64Coin("scam", 1)),
+		types.ErrBlacklistedDenom,
+	)
+}
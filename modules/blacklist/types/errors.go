@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// blacklist module sentinel errors
+var (
+	ErrInvalidDenom     = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	ErrBlacklistedDenom = sdkerrors.Register(ModuleName, 3, "blacklisted denom")
+)
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store key
+var (
+	// params store for the blacklisted denoms
+	KeyDenoms = []byte("Denoms")
+)
+
+// ParamKeyTable for blacklist module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs Params
+func NewParams(denoms []string) Params {
+	return Params{
+		Denoms: denoms,
+	}
+}
+
+// DefaultParams returns default blacklist module parameters
+func DefaultParams() Params {
+	return NewParams(nil)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDenoms, &p.Denoms, validateDenoms),
+	}
+}
+
+// GetParamSpace implements params.ParamStruct
+func (p *Params) GetParamSpace() string {
+	return DefaultParamSpace
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	return validateDenoms(p.Denoms)
+}
+
+// IsBlacklisted returns true if the given denom is blacklisted
+func (p Params) IsBlacklisted(denom string) bool {
+	for _, d := range p.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func validateDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+		}
+		if denom == sdk.DefaultBondDenom {
+			return sdkerrors.Wrapf(ErrInvalidDenom, "the staking denom [%s] can not be blacklisted", denom)
+		}
+		if denoms[denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenom, "duplicate denom [%s]", denom)
+		}
+		denoms[denom] = true
+	}
+
+	return nil
+}
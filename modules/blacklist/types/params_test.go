@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"valid denoms", NewParams([]string{"scam", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}), true},
+		{"invalid denom", NewParams([]string{"1scam"}), false},
+		{"staking denom", NewParams([]string{sdk.DefaultBondDenom}), false},
+		{"duplicate denom", NewParams([]string{"scam", "scam"}), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
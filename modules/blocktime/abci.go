@@ -0,0 +1,12 @@
+package blocktime
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/blocktime/keeper"
+)
+
+// BeginBlocker records the time of the current block
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.RecordBlockTime(ctx)
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/blocktime/types"
+)
+
+// GetQueryCmd returns the cli query commands for the blocktime module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the blocktime module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryBlockTime(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryBlockTime implements a command to return the median block time.
+func GetCmdQueryBlockTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "median",
+		Short:   "Query the median block time over the recent blocks",
+		Example: fmt.Sprintf("%s query blocktime median", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockTime(context.Background(), &types.QueryBlockTimeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
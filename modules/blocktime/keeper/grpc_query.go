@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/blocktime/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// BlockTime queries the median block time over the recent blocks
+func (k Keeper) BlockTime(c context.Context, _ *types.QueryBlockTimeRequest) (*types.QueryBlockTimeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	blockTime, samples := k.medianBlockTime(ctx)
+
+	return &types.QueryBlockTimeResponse{BlockTime: blockTime, Samples: uint32(samples)}, nil
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/blocktime/types"
+)
+
+var _ types.BlockTimeOracle = Keeper{}
+
+// Keeper of the blocktime store
+type Keeper struct {
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper returns a blocktime keeper
+func NewKeeper(key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// RecordBlockTime stores the time of the current block and prunes the
+// block times which fell out of the sample window
+func (k Keeper) RecordBlockTime(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBlockTimeKey(ctx.BlockHeight()), sdk.FormatTimeBytes(ctx.BlockTime()))
+
+	if pruned := ctx.BlockHeight() - types.SampleWindow; pruned > 0 {
+		store.Delete(types.GetBlockTimeKey(pruned))
+	}
+}
+
+// GetBlockTimes returns the times of the recent blocks in ascending height order
+func (k Keeper) GetBlockTimes(ctx sdk.Context) []time.Time {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockTimeKey)
+	defer iterator.Close()
+
+	var times []time.Time
+	for ; iterator.Valid(); iterator.Next() {
+		t, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		times = append(times, t)
+	}
+	return times
+}
+
+// MedianBlockTime returns the median interval between the recent blocks, or
+// the default block time if less than two blocks have been recorded
+func (k Keeper) MedianBlockTime(ctx sdk.Context) time.Duration {
+	blockTime, _ := k.medianBlockTime(ctx)
+	return blockTime
+}
+
+// EstimateBlocks returns the number of blocks expected to be produced in the
+// given duration, rounded up
+func (k Keeper) EstimateBlocks(ctx sdk.Context, duration time.Duration) int64 {
+	if duration <= 0 {
+		return 0
+	}
+
+	blockTime := k.MedianBlockTime(ctx)
+	return int64((duration + blockTime - 1) / blockTime)
+}
+
+// EstimateDuration returns the time expected for the given number of blocks to be produced
+func (k Keeper) EstimateDuration(ctx sdk.Context, blocks int64) time.Duration {
+	return time.Duration(blocks) * k.MedianBlockTime(ctx)
+}
+
+// medianBlockTime returns the median block interval and the number of intervals it is computed over
+func (k Keeper) medianBlockTime(ctx sdk.Context) (time.Duration, int) {
+	times := k.GetBlockTimes(ctx)
+	if len(times) < 2 {
+		return types.DefaultBlockTime, 0
+	}
+
+	intervals := make([]time.Duration, len(times)-1)
+	for i := range intervals {
+		intervals[i] = times[i+1].Sub(times[i])
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i] < intervals[j] })
+
+	n := len(intervals)
+	median := intervals[n/2]
+	if n%2 == 0 {
+		median = (intervals[n/2-1] + intervals[n/2]) / 2
+	}
+
+	// a block can not be produced in no time, fall back to the default
+	// rather than let the estimations divide by zero
+	if median <= 0 {
+		return types.DefaultBlockTime, n
+	}
+	return median, n
+}
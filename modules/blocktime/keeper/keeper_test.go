@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/blocktime/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// recordBlocks records blocks from the given height separated by the given intervals
+func (suite *KeeperTestSuite) recordBlocks(height int64, start time.Time, intervals ...time.Duration) {
+	ctx := suite.ctx.WithBlockHeight(height).WithBlockTime(start)
+	suite.app.BlockTimeKeeper.RecordBlockTime(ctx)
+
+	for _, interval := range intervals {
+		height++
+		start = start.Add(interval)
+		ctx = suite.ctx.WithBlockHeight(height).WithBlockTime(start)
+		suite.app.BlockTimeKeeper.RecordBlockTime(ctx)
+	}
+}
+
+func (suite *KeeperTestSuite) TestDefaultBlockTime() {
+	suite.Equal(types.DefaultBlockTime, suite.app.BlockTimeKeeper.MedianBlockTime(suite.ctx))
+
+	suite.recordBlocks(1, time.Now())
+	suite.Equal(types.DefaultBlockTime, suite.app.BlockTimeKeeper.MedianBlockTime(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMedianBlockTime() {
+	start := time.Now()
+	suite.recordBlocks(1, start, 6*time.Second, 2*time.Second, time.Minute)
+	suite.Equal(6*time.Second, suite.app.BlockTimeKeeper.MedianBlockTime(suite.ctx))
+
+	suite.recordBlocks(5, start.Add(72*time.Second), 6*time.Second, 4*time.Second)
+	suite.Equal(5*time.Second, suite.app.BlockTimeKeeper.MedianBlockTime(suite.ctx))
+
+	suite.Equal(int64(3), suite.app.BlockTimeKeeper.EstimateBlocks(suite.ctx, 11*time.Second))
+	suite.Equal(int64(0), suite.app.BlockTimeKeeper.EstimateBlocks(suite.ctx, 0))
+	suite.Equal(20*time.Second, suite.app.BlockTimeKeeper.EstimateDuration(suite.ctx, 4))
+}
+
+func (suite *KeeperTestSuite) TestSampleWindow() {
+	intervals := make([]time.Duration, types.SampleWindow+10)
+	for i := range intervals {
+		intervals[i] = 3 * time.Second
+		if i < 60 {
+			intervals[i] = 7 * time.Second
+		}
+	}
+
+	suite.recordBlocks(1, time.Now(), intervals...)
+	suite.Len(suite.app.BlockTimeKeeper.GetBlockTimes(suite.ctx), types.SampleWindow)
+	suite.Equal(3*time.Second, suite.app.BlockTimeKeeper.MedianBlockTime(suite.ctx))
+}
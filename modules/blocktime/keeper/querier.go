@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/blocktime/types"
+)
+
+// NewQuerier returns a blocktime Querier handler.
+func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryBlockTime:
+			return queryBlockTime(ctx, k, legacyQuerierCdc)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+	}
+}
+
+func queryBlockTime(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	blockTime, samples := k.medianBlockTime(ctx)
+
+	res, err := codec.MarshalJSONIndent(
+		legacyQuerierCdc,
+		types.QueryBlockTimeResponse{BlockTime: blockTime, Samples: uint32(samples)},
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
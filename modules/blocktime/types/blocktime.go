@@ -0,0 +1,26 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// SampleWindow is the number of recent block times kept to compute the median block time
+	SampleWindow = 100
+
+	// DefaultBlockTime is the block time assumed until at least two blocks have been recorded
+	DefaultBlockTime = 5 * time.Second
+)
+
+// BlockTimeOracle defines the interface through which modules estimate the
+// passing of time in blocks, instead of assuming a hard-coded block time.
+type BlockTimeOracle interface {
+	// MedianBlockTime returns the median interval between the recent blocks
+	MedianBlockTime(ctx sdk.Context) time.Duration
+	// EstimateBlocks returns the number of blocks expected to be produced in the given duration
+	EstimateBlocks(ctx sdk.Context, duration time.Duration) int64
+	// EstimateDuration returns the time expected for the given number of blocks to be produced
+	EstimateDuration(ctx sdk.Context, blocks int64) time.Duration
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "blocktime"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the blocktime querier
+	QueryBlockTime = "block_time"
+)
+
+var (
+	// Keys for store prefixes
+	BlockTimeKey = []byte{0x01} // prefix for the time of each recent block
+)
+
+// GetBlockTimeKey returns the key of the time of the given block
+func GetBlockTimeKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(BlockTimeKey, bz...)
+}
@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blocktime/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBlockTimeRequest is request type for the Query/BlockTime RPC method
+type QueryBlockTimeRequest struct {
+}
+
+func (m *QueryBlockTimeRequest) Reset()         { *m = QueryBlockTimeRequest{} }
+func (m *QueryBlockTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockTimeRequest) ProtoMessage()    {}
+func (*QueryBlockTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6eb67ab60d65300, []int{0}
+}
+func (m *QueryBlockTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockTimeRequest.Merge(m, src)
+}
+func (m *QueryBlockTimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockTimeRequest proto.InternalMessageInfo
+
+// QueryBlockTimeResponse is response type for the Query/BlockTime RPC method
+type QueryBlockTimeResponse struct {
+	// the median interval between the recent blocks
+	BlockTime time.Duration `protobuf:"bytes,1,opt,name=block_time,json=blockTime,proto3,stdduration" json:"block_time" yaml:"block_time"`
+	// the number of block intervals the median is computed over
+	Samples uint32 `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *QueryBlockTimeResponse) Reset()         { *m = QueryBlockTimeResponse{} }
+func (m *QueryBlockTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockTimeResponse) ProtoMessage()    {}
+func (*QueryBlockTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6eb67ab60d65300, []int{1}
+}
+func (m *QueryBlockTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockTimeResponse.Merge(m, src)
+}
+func (m *QueryBlockTimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockTimeResponse proto.InternalMessageInfo
+
+func (m *QueryBlockTimeResponse) GetBlockTime() time.Duration {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *QueryBlockTimeResponse) GetSamples() uint32 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryBlockTimeRequest)(nil), "irishub.blocktime.QueryBlockTimeRequest")
+	proto.RegisterType((*QueryBlockTimeResponse)(nil), "irishub.blocktime.QueryBlockTimeResponse")
+}
+
+func init() { proto.RegisterFile("blocktime/query.proto", fileDescriptor_e6eb67ab60d65300) }
+
+var fileDescriptor_e6eb67ab60d65300 = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xb1, 0x4b, 0xfb, 0x40,
+	0x14, 0xc7, 0x73, 0x85, 0xdf, 0x4f, 0x7a, 0xe2, 0xd0, 0x60, 0xb5, 0x16, 0x7b, 0x29, 0x01, 0xa1,
+	0x2e, 0x77, 0x50, 0x37, 0xc7, 0xe2, 0xe8, 0x62, 0x11, 0x04, 0x17, 0x49, 0xda, 0x33, 0x1e, 0x26,
+	0x79, 0x69, 0xee, 0x6e, 0xe8, 0xea, 0xe2, 0xe0, 0xa2, 0xb8, 0xf8, 0x27, 0x75, 0x2c, 0xb8, 0x38,
+	0x55, 0x69, 0xfd, 0x0b, 0xfc, 0x0b, 0x24, 0x97, 0xb4, 0x11, 0x75, 0x70, 0xbb, 0xc7, 0xf7, 0xbd,
+	0xef, 0xe7, 0xfb, 0xde, 0xe1, 0xba, 0x1f, 0xc2, 0xe0, 0x5a, 0x89, 0x88, 0xb3, 0x91, 0xe6, 0xe9,
+	0x98, 0x26, 0x29, 0x28, 0xb0, 0x6b, 0x22, 0x15, 0xf2, 0x4a, 0xfb, 0x74, 0x25, 0x37, 0x37, 0x03,
+	0x08, 0xc0, 0xa8, 0x2c, 0x7b, 0xe5, 0x8d, 0xcd, 0xdd, 0x00, 0x20, 0x08, 0x39, 0xf3, 0x12, 0xc1,
+	0xbc, 0x38, 0x06, 0xe5, 0x29, 0x01, 0xb1, 0x2c, 0x54, 0x52, 0xa8, 0xa6, 0xf2, 0xf5, 0x25, 0x1b,
+	0xea, 0xd4, 0x34, 0xe4, 0xba, 0xbb, 0x8d, 0xeb, 0x27, 0x19, 0xb5, 0x97, 0x51, 0x4e, 0x45, 0xc4,
+	0xfb, 0x7c, 0xa4, 0xb9, 0x54, 0xee, 0x1d, 0xc2, 0x5b, 0xdf, 0x15, 0x99, 0x40, 0x2c, 0xb9, 0x7d,
+	0x86, 0xb1, 0x09, 0x75, 0x91, 0xa5, 0x6a, 0xa0, 0x36, 0xea, 0xac, 0x77, 0x77, 0x68, 0x0e, 0xa2,
+	0x4b, 0x10, 0x3d, 0x2a, 0x40, 0xbd, 0xd6, 0x64, 0xe6, 0x58, 0x1f, 0x33, 0xa7, 0x36, 0xf6, 0xa2,
+	0xf0, 0xd0, 0x2d, 0x47, 0xdd, 0xa7, 0x57, 0x07, 0xf5, 0xab, 0xfe, 0x12, 0x60, 0x37, 0xf0, 0x9a,
+	0xf4, 0xa2, 0x24, 0xe4, 0xb2, 0x51, 0x69, 0xa3, 0xce, 0x46, 0x7f, 0x59, 0x76, 0x1f, 0x10, 0xfe,
+	0x67, 0xd2, 0xd8, 0xb7, 0x08, 0x57, 0x57, 0x91, 0xec, 0x0e, 0xfd, 0x71, 0x26, 0xfa, 0xeb, 0x3e,
+	0xcd, 0xfd, 0x3f, 0x74, 0xe6, 0xfb, 0xb9, 0x7b, 0x37, 0xcf, 0xef, 0x8f, 0x15, 0xc7, 0x6e, 0xb1,
+	0x62, 0x84, 0x95, 0x5f, 0x54, 0xa6, 0xef, 0x1d, 0x4f, 0xe6, 0x04, 0x4d, 0xe7, 0x04, 0xbd, 0xcd,
+	0x09, 0xba, 0x5f, 0x10, 0x6b, 0xba, 0x20, 0xd6, 0xcb, 0x82, 0x58, 0xe7, 0xdd, 0x40, 0xa8, 0x8c,
+	0x34, 0x80, 0xc8, 0x58, 0xc4, 0x5c, 0xad, 0xac, 0x22, 0x18, 0xea, 0x90, 0xcb, 0x2f, 0x96, 0x6a,
+	0x9c, 0x70, 0xe9, 0xff, 0x37, 0x87, 0x3b, 0xf8, 0x1c, 0x00, 0x5d, 0xaf, 0xf6, 0x6e, 0x0f, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// BlockTime queries the median block time over the recent blocks
+	BlockTime(ctx context.Context, in *QueryBlockTimeRequest, opts ...grpc.CallOption) (*QueryBlockTimeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) BlockTime(ctx context.Context, in *QueryBlockTimeRequest, opts ...grpc.CallOption) (*QueryBlockTimeResponse, error) {
+	out := new(QueryBlockTimeResponse)
+	err := c.cc.Invoke(ctx, "/irishub.blocktime.Query/BlockTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// BlockTime queries the median block time over the recent blocks
+	BlockTime(context.Context, *QueryBlockTimeRequest) (*QueryBlockTimeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) BlockTime(ctx context.Context, req *QueryBlockTimeRequest) (*QueryBlockTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockTime not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_BlockTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.blocktime.Query/BlockTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockTime(ctx, req.(*QueryBlockTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.blocktime.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlockTime",
+			Handler:    _Query_BlockTime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blocktime/query.proto",
+}
+
+func (m *QueryBlockTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x10
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBlockTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovQuery(uint64(m.Samples))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBlockTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
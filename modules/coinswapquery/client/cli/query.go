@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	"github.com/irisnet/irishub/modules/coinswapquery/types"
+)
+
+// swap directions of the estimate-swap command
+const (
+	directionBuy  = "buy"
+	directionSell = "sell"
+)
+
+// GetQueryCmd returns the cli query commands for the coinswap module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        coinswaptypes.ModuleName,
+		Short:                      "Querying commands for the coinswap module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryPool(),
+		GetCmdQueryPools(),
+		GetCmdQueryEstimateSwap(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryPool implements the query pool command.
+func GetCmdQueryPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pool [denom]",
+		Short:   "Query the reserves and the liquidity of the pool of a token",
+		Example: fmt.Sprintf("%s query coinswap pool btc", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pool(context.Background(), &types.QueryPoolRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPools implements the query pools command.
+func GetCmdQueryPools() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pools",
+		Short:   "Query all the pools",
+		Example: fmt.Sprintf("%s query coinswap pools", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Pools(context.Background(), &types.QueryPoolsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pools")
+	return cmd
+}
+
+// GetCmdQueryEstimateSwap implements the query swap estimate command.
+func GetCmdQueryEstimateSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap [buy|sell] [coin] [denom]",
+		Short: "Estimate a swap at the current reserves of the pools",
+		Long: "Estimate a swap at the current reserves of the pools. Buying returns the amount of denom " +
+			"to sell for exactly coin, selling returns the amount of denom bought for exactly coin.",
+		Example: fmt.Sprintf("%s query coinswap estimate-swap sell 1000uiris btc", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if args[0] != directionBuy && args[0] != directionSell {
+				return fmt.Errorf("invalid swap direction %s, expected %s or %s", args[0], directionBuy, directionSell)
+			}
+
+			exact, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwap(context.Background(), &types.QueryEstimateSwapRequest{
+				Exact:      exact,
+				Denom:      args[2],
+				IsBuyOrder: args[0] == directionBuy,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
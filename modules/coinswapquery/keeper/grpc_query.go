@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/coinswapquery/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Pool implements the Query/Pool gRPC method
+func (k Keeper) Pool(c context.Context, req *types.QueryPoolRequest) (*types.QueryPoolResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "denom cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pool, err := k.GetPool(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolResponse{Pool: pool}, nil
+}
+
+// Pools implements the Query/Pools gRPC method
+func (k Keeper) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pools, pageRes, err := paginatePools(k.GetPools(ctx), req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryPoolsResponse{Pools: pools, Pagination: pageRes}, nil
+}
+
+// EstimateSwap implements the Query/EstimateSwap gRPC method
+func (k Keeper) EstimateSwap(c context.Context, req *types.QueryEstimateSwapRequest) (*types.QueryEstimateSwapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	input, output, err := k.EstimateSwapCoins(ctx, req.Exact, req.Denom, req.IsBuyOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateSwapResponse{Input: input, Output: output}, nil
+}
+
+// paginatePools returns the page of the pools, ordered by token denom, requested by
+// the given PageRequest. The key of a page is the token denom of its first pool.
+func paginatePools(pools []types.Pool, pageReq *query.PageRequest) ([]types.Pool, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	offset := pageReq.Offset
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+
+	if offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if limit == 0 {
+		limit = query.DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	start := offset
+	if len(pageReq.Key) != 0 {
+		start = uint64(sort.Search(len(pools), func(i int) bool {
+			return pools[i].Denom >= string(pageReq.Key)
+		}))
+	}
+	if start > uint64(len(pools)) {
+		start = uint64(len(pools))
+	}
+	end := start + limit
+	if end > uint64(len(pools)) {
+		end = uint64(len(pools))
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < uint64(len(pools)) {
+		pageRes.NextKey = []byte(pools[end].Denom)
+	}
+	if countTotal && len(pageReq.Key) == 0 {
+		pageRes.Total = uint64(len(pools))
+	}
+	return pools[start:end], pageRes, nil
+}
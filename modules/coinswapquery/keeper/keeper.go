@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	"github.com/irisnet/irishub/modules/coinswapquery/types"
+)
+
+// Keeper of the coinswap queries, which reads the pools of the coinswap module
+type Keeper struct {
+	coinswapKeeper types.CoinswapKeeper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper returns a coinswap query keeper
+func NewKeeper(coinswapKeeper types.CoinswapKeeper, bankKeeper types.BankKeeper) Keeper {
+	return Keeper{
+		coinswapKeeper: coinswapKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// GetPool returns the pool of the given token denom
+func (k Keeper) GetPool(ctx sdk.Context, denom string) (types.Pool, error) {
+	standardDenom := k.coinswapKeeper.GetStandardDenom(ctx)
+	if denom == standardDenom {
+		return types.Pool{}, sdkerrors.Wrapf(coinswaptypes.ErrReservePoolNotExists, "the standard denom %s has no pool", denom)
+	}
+
+	uniDenom := coinswaptypes.FormatUniABSPrefix + denom
+	liquidity := k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(uniDenom)
+	reserves := k.coinswapKeeper.GetReservePool(ctx, uniDenom)
+	if liquidity.IsZero() && reserves.Empty() {
+		return types.Pool{}, sdkerrors.Wrap(coinswaptypes.ErrReservePoolNotExists, uniDenom)
+	}
+
+	return types.NewPool(denom, standardDenom, reserves, sdk.NewCoin(uniDenom, liquidity), k.coinswapKeeper.GetParams(ctx).Fee), nil
+}
+
+// GetPools returns all the pools, ordered by token denom. The pools are
+// the ones whose liquidity token has a supply.
+func (k Keeper) GetPools(ctx sdk.Context) []types.Pool {
+	standardDenom := k.coinswapKeeper.GetStandardDenom(ctx)
+	fee := k.coinswapKeeper.GetParams(ctx).Fee
+
+	var pools []types.Pool
+	for _, liquidity := range k.bankKeeper.GetSupply(ctx).GetTotal() {
+		if !strings.HasPrefix(liquidity.Denom, coinswaptypes.FormatUniABSPrefix) {
+			continue
+		}
+		denom := strings.TrimPrefix(liquidity.Denom, coinswaptypes.FormatUniABSPrefix)
+		reserves := k.coinswapKeeper.GetReservePool(ctx, liquidity.Denom)
+		pools = append(pools, types.NewPool(denom, standardDenom, reserves, liquidity, fee))
+	}
+	return pools
+}
+
+// EstimateSwapCoins returns the input and the output of a swap of the given coin
+// for the given denom at the current reserves of the pools. For a buy order
+// the exact coin is the output of the swap, otherwise it is the input. The
+// swaps between two tokens trade through the standard denom, as the coinswap
+// keeper does.
+func (k Keeper) EstimateSwapCoins(ctx sdk.Context, exact sdk.Coin, denom string, isBuyOrder bool) (input, output sdk.Coin, err error) {
+	if !exact.IsValid() || !exact.IsPositive() {
+		return input, output, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid exact coin: %s", exact)
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return input, output, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if exact.Denom == denom {
+		return input, output, coinswaptypes.ErrEqualDenom
+	}
+
+	standardDenom := k.coinswapKeeper.GetStandardDenom(ctx)
+	double := exact.Denom != standardDenom && denom != standardDenom
+
+	if isBuyOrder {
+		bought := exact
+		if double {
+			amount, err := k.estimateWithExactOutput(ctx, exact, standardDenom)
+			if err != nil {
+				return input, output, err
+			}
+			bought = sdk.NewCoin(standardDenom, amount)
+		}
+		amount, err := k.estimateWithExactOutput(ctx, bought, denom)
+		if err != nil {
+			return input, output, err
+		}
+		return sdk.NewCoin(denom, amount), exact, nil
+	}
+
+	sold := exact
+	if double {
+		amount, err := k.estimateWithExactInput(ctx, exact, standardDenom)
+		if err != nil {
+			return input, output, err
+		}
+		sold = sdk.NewCoin(standardDenom, amount)
+	}
+	amount, err := k.estimateWithExactInput(ctx, sold, denom)
+	if err != nil {
+		return input, output, err
+	}
+	return exact, sdk.NewCoin(denom, amount), nil
+}
+
+// estimateWithExactInput returns the amount of the given denom bought with the exact sold coin
+func (k Keeper) estimateWithExactInput(ctx sdk.Context, exactSoldCoin sdk.Coin, boughtDenom string) (sdk.Int, error) {
+	inputReserve, outputReserve, err := k.getReserves(ctx, exactSoldCoin.Denom, boughtDenom)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	fee := k.coinswapKeeper.GetParams(ctx).Fee
+	return coinswapkeeper.GetInputPrice(exactSoldCoin.Amount, inputReserve, outputReserve, fee), nil
+}
+
+// estimateWithExactOutput returns the amount of the given denom sold for the exact bought coin
+func (k Keeper) estimateWithExactOutput(ctx sdk.Context, exactBoughtCoin sdk.Coin, soldDenom string) (sdk.Int, error) {
+	inputReserve, outputReserve, err := k.getReserves(ctx, soldDenom, exactBoughtCoin.Denom)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if exactBoughtCoin.Amount.GTE(outputReserve) {
+		return sdk.ZeroInt(), sdkerrors.Wrap(
+			coinswaptypes.ErrInsufficientFunds,
+			fmt.Sprintf("reserve pool insufficient balance of %s, expected: %s, actual: %s", exactBoughtCoin.Denom, exactBoughtCoin.Amount, outputReserve),
+		)
+	}
+
+	fee := k.coinswapKeeper.GetParams(ctx).Fee
+	return coinswapkeeper.GetOutputPrice(exactBoughtCoin.Amount, inputReserve, outputReserve, fee), nil
+}
+
+// getReserves returns the reserves of the input and output denoms in the pool trading them
+func (k Keeper) getReserves(ctx sdk.Context, inputDenom, outputDenom string) (inputReserve, outputReserve sdk.Int, err error) {
+	uniDenom, err := k.coinswapKeeper.GetUniDenomFromDenoms(ctx, inputDenom, outputDenom)
+	if err != nil {
+		return inputReserve, outputReserve, err
+	}
+	reservePool := k.coinswapKeeper.GetReservePool(ctx, uniDenom)
+	if reservePool == nil {
+		return inputReserve, outputReserve, sdkerrors.Wrap(coinswaptypes.ErrReservePoolNotExists, uniDenom)
+	}
+
+	inputReserve = reservePool.AmountOf(inputDenom)
+	outputReserve = reservePool.AmountOf(outputDenom)
+	if !inputReserve.IsPositive() || !outputReserve.IsPositive() {
+		return inputReserve, outputReserve, sdkerrors.Wrap(
+			coinswaptypes.ErrInsufficientFunds,
+			fmt.Sprintf("reserve pool %s insufficient funds: %s", uniDenom, reservePool),
+		)
+	}
+	return inputReserve, outputReserve, nil
+}
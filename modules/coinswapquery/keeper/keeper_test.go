@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	coinswapkeeper "github.com/irisnet/irismod/modules/coinswap/keeper"
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+
+	"github.com/irisnet/irishub/modules/coinswapquery/keeper"
+	"github.com/irisnet/irishub/modules/coinswapquery/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var sender = sdk.AccAddress([]byte("coinswap-test-sender"))
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *simapp.SimApp
+	keeper      keeper.Keeper
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	suite.app = app
+	suite.keeper = keeper.NewKeeper(app.CoinswapKeeper, app.BankKeeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.keeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	standardDenom := app.CoinswapKeeper.GetStandardDenom(suite.ctx)
+	funds := sdk.NewCoins(
+		sdk.NewInt64Coin(standardDenom, 10000), sdk.NewInt64Coin("btc", 10000), sdk.NewInt64Coin("eth", 10000),
+	)
+	suite.NoError(app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, funds))
+	suite.NoError(app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, funds))
+
+	suite.addLiquidity(sdk.NewInt64Coin("btc", 1000), 1000)
+	suite.addLiquidity(sdk.NewInt64Coin("eth", 4000), 2000)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) addLiquidity(token sdk.Coin, standardAmt int64) {
+	_, err := suite.app.CoinswapKeeper.AddLiquidity(suite.ctx, &coinswaptypes.MsgAddLiquidity{
+		MaxToken:         token,
+		ExactStandardAmt: sdk.NewInt(standardAmt),
+		MinLiquidity:     sdk.NewInt(1),
+		Deadline:         suite.ctx.BlockTime().Add(time.Hour).Unix(),
+		Sender:           sender.String(),
+	})
+	suite.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestGetPool() {
+	standardDenom := suite.app.CoinswapKeeper.GetStandardDenom(suite.ctx)
+
+	pool, err := suite.keeper.GetPool(suite.ctx, "eth")
+	suite.NoError(err)
+	suite.Equal("eth", pool.Denom)
+	suite.Equal(coinswaptypes.GetReservePoolAddr("swap/eth").String(), pool.ReserveAddress)
+	suite.Equal(sdk.NewInt64Coin(standardDenom, 2000), pool.Standard)
+	suite.Equal(sdk.NewInt64Coin("eth", 4000), pool.Token)
+	suite.Equal(sdk.NewInt64Coin("swap/eth", 2000), pool.Liquidity)
+
+	_, err = suite.keeper.GetPool(suite.ctx, "atom")
+	suite.Error(err)
+	_, err = suite.keeper.GetPool(suite.ctx, standardDenom)
+	suite.Error(err)
+
+	res, err := suite.queryClient.Pool(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolRequest{Denom: "btc"})
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin("btc", 1000), res.Pool.Token)
+	suite.Equal(suite.app.CoinswapKeeper.GetParams(suite.ctx).Fee, res.Pool.Fee)
+}
+
+func (suite *KeeperTestSuite) TestPools() {
+	pools := suite.keeper.GetPools(suite.ctx)
+	suite.Len(pools, 2)
+	suite.Equal("btc", pools[0].Denom)
+	suite.Equal("eth", pools[1].Denom)
+
+	res, err := suite.queryClient.Pools(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(res.Pools, 1)
+	suite.Equal("btc", res.Pools[0].Denom)
+	suite.Equal(uint64(2), res.Pagination.Total)
+	suite.Equal([]byte("eth"), res.Pagination.NextKey)
+
+	res, err = suite.queryClient.Pools(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	suite.NoError(err)
+	suite.Len(res.Pools, 1)
+	suite.Equal("eth", res.Pools[0].Denom)
+	suite.Nil(res.Pagination.NextKey)
+
+	res, err = suite.queryClient.Pools(sdk.WrapSDKContext(suite.ctx), &types.QueryPoolsRequest{
+		Pagination: &query.PageRequest{Offset: 5},
+	})
+	suite.NoError(err)
+	suite.Empty(res.Pools)
+}
+
+func (suite *KeeperTestSuite) TestEstimateSwap() {
+	standardDenom := suite.app.CoinswapKeeper.GetStandardDenom(suite.ctx)
+
+	testCases := []struct {
+		name       string
+		exact      sdk.Coin
+		denom      string
+		isBuyOrder bool
+	}{
+		{"sell standard for token", sdk.NewInt64Coin(standardDenom, 100), "btc", false},
+		{"sell token for standard", sdk.NewInt64Coin("btc", 100), standardDenom, false},
+		{"sell token for token", sdk.NewInt64Coin("btc", 100), "eth", false},
+		{"buy token with standard", sdk.NewInt64Coin("btc", 100), standardDenom, true},
+		{"buy standard with token", sdk.NewInt64Coin(standardDenom, 100), "eth", true},
+		{"buy token with token", sdk.NewInt64Coin("eth", 100), "btc", true},
+	}
+	for _, tc := range testCases {
+		res, err := suite.queryClient.EstimateSwap(sdk.WrapSDKContext(suite.ctx), &types.QueryEstimateSwapRequest{
+			Exact: tc.exact, Denom: tc.denom, IsBuyOrder: tc.isBuyOrder,
+		})
+		suite.NoError(err, tc.name)
+
+		// the estimate matches the swap executed by the coinswap module
+		ctx, _ := suite.ctx.CacheContext()
+		before := suite.app.BankKeeper.GetAllBalances(ctx, sender)
+		_, err = coinswapkeeper.NewMsgServerImpl(suite.app.CoinswapKeeper).SwapCoin(sdk.WrapSDKContext(ctx), &coinswaptypes.MsgSwapOrder{
+			Input:      coinswaptypes.Input{Address: sender.String(), Coin: res.Input},
+			Output:     coinswaptypes.Output{Address: sender.String(), Coin: res.Output},
+			Deadline:   ctx.BlockTime().Add(time.Hour).Unix(),
+			IsBuyOrder: tc.isBuyOrder,
+		})
+		suite.NoError(err, tc.name)
+
+		after := suite.app.BankKeeper.GetAllBalances(ctx, sender)
+		suite.Equal(before.Sub(sdk.NewCoins(res.Input)).Add(res.Output), after, tc.name)
+	}
+
+	_, _, err := suite.keeper.EstimateSwapCoins(suite.ctx, sdk.NewInt64Coin("btc", 1000), standardDenom, true)
+	suite.Error(err, "buying the whole reserve")
+	_, _, err = suite.keeper.EstimateSwapCoins(suite.ctx, sdk.NewInt64Coin("atom", 100), standardDenom, false)
+	suite.Error(err, "selling a token without pool")
+	_, _, err = suite.keeper.EstimateSwapCoins(suite.ctx, sdk.NewInt64Coin("btc", 100), "btc", false)
+	suite.Error(err, "swapping a token for itself")
+}
@@ -0,0 +1,587 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coinswapquery/coinswapquery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Pool defines the reserves, the liquidity and the swap fee of a coinswap pool
+type Pool struct {
+	Denom          string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ReserveAddress string                                 `protobuf:"bytes,2,opt,name=reserve_address,json=reserveAddress,proto3" json:"reserve_address,omitempty" yaml:"reserve_address"`
+	Standard       types.Coin                             `protobuf:"bytes,3,opt,name=standard,proto3" json:"standard"`
+	Token          types.Coin                             `protobuf:"bytes,4,opt,name=token,proto3" json:"token"`
+	Liquidity      types.Coin                             `protobuf:"bytes,5,opt,name=liquidity,proto3" json:"liquidity"`
+	Fee            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee"`
+}
+
+func (m *Pool) Reset()         { *m = Pool{} }
+func (m *Pool) String() string { return proto.CompactTextString(m) }
+func (*Pool) ProtoMessage()    {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69ae061893646675, []int{0}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+func (m *Pool) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Pool) GetReserveAddress() string {
+	if m != nil {
+		return m.ReserveAddress
+	}
+	return ""
+}
+
+func (m *Pool) GetStandard() types.Coin {
+	if m != nil {
+		return m.Standard
+	}
+	return types.Coin{}
+}
+
+func (m *Pool) GetToken() types.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types.Coin{}
+}
+
+func (m *Pool) GetLiquidity() types.Coin {
+	if m != nil {
+		return m.Liquidity
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*Pool)(nil), "irishub.coinswapquery.Pool")
+}
+
+func init() { proto.RegisterFile("coinswapquery/coinswapquery.proto", fileDescriptor_69ae061893646675) }
+
+var fileDescriptor_69ae061893646675 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4e, 0xea, 0x40,
+	0x14, 0x6e, 0xf9, 0xcb, 0x65, 0x6e, 0x72, 0x6f, 0xd2, 0xa0, 0xa9, 0x2c, 0x0a, 0xb2, 0x30, 0x6c,
+	0x9c, 0x09, 0x1a, 0x5d, 0x68, 0x4c, 0x14, 0x7c, 0x00, 0xd2, 0xa5, 0x1b, 0x33, 0x65, 0x8e, 0x38,
+	0xa1, 0xed, 0xc0, 0xcc, 0x14, 0xd3, 0xb7, 0xf0, 0xb1, 0x58, 0xb2, 0x32, 0xc6, 0x05, 0x31, 0xf0,
+	0x06, 0x3e, 0x81, 0x69, 0xa7, 0x51, 0x71, 0xc5, 0xaa, 0x3d, 0xe7, 0xfb, 0xc9, 0x37, 0xe7, 0x43,
+	0x87, 0x23, 0xc1, 0x63, 0xf5, 0x44, 0xa7, 0xb3, 0x04, 0x64, 0x4a, 0xb6, 0x26, 0x3c, 0x95, 0x42,
+	0x0b, 0x67, 0x8f, 0x4b, 0xae, 0x1e, 0x93, 0x00, 0x6f, 0x81, 0xcd, 0xc6, 0x58, 0x8c, 0x45, 0xce,
+	0x20, 0xd9, 0x9f, 0x21, 0x37, 0xbd, 0x91, 0x50, 0x91, 0x50, 0x24, 0xa0, 0x0a, 0xc8, 0xbc, 0x17,
+	0x80, 0xa6, 0xbd, 0xdc, 0xd5, 0xe0, 0x9d, 0x97, 0x12, 0xaa, 0x0c, 0x85, 0x08, 0x9d, 0x06, 0xaa,
+	0x32, 0x88, 0x45, 0xe4, 0xda, 0x6d, 0xbb, 0x5b, 0xf7, 0xcd, 0xe0, 0x0c, 0xd0, 0x7f, 0x09, 0x0a,
+	0xe4, 0x1c, 0xee, 0x29, 0x63, 0x12, 0x94, 0x72, 0x4b, 0x19, 0xde, 0x6f, 0x7e, 0xac, 0x5a, 0xfb,
+	0x29, 0x8d, 0xc2, 0x8b, 0xce, 0x2f, 0x42, 0xc7, 0xff, 0x57, 0x6c, 0x6e, 0xcc, 0xc2, 0xb9, 0x44,
+	0x7f, 0x94, 0xa6, 0x31, 0xa3, 0x92, 0xb9, 0xe5, 0xb6, 0xdd, 0xfd, 0x7b, 0x72, 0x80, 0x4d, 0x2c,
+	0x9c, 0xc5, 0xc2, 0x45, 0x2c, 0x3c, 0x10, 0x3c, 0xee, 0x57, 0x16, 0xab, 0x96, 0xe5, 0x7f, 0x09,
+	0x9c, 0x33, 0x54, 0xd5, 0x62, 0x02, 0xb1, 0x5b, 0xd9, 0x4d, 0x69, 0xd8, 0xce, 0x15, 0xaa, 0x87,
+	0x7c, 0x96, 0x70, 0xc6, 0x75, 0xea, 0x56, 0x77, 0x93, 0x7e, 0x2b, 0x9c, 0x6b, 0x54, 0x7e, 0x00,
+	0x70, 0x6b, 0xf9, 0x5b, 0x71, 0x86, 0xbe, 0xad, 0x5a, 0x47, 0x63, 0xae, 0xcd, 0xdd, 0x23, 0x52,
+	0x9c, 0xd5, 0x7c, 0x8e, 0x15, 0x9b, 0x10, 0x9d, 0x4e, 0x41, 0xe1, 0x5b, 0x18, 0xf9, 0x99, 0xb4,
+	0x3f, 0x5c, 0xac, 0x3d, 0x7b, 0xb9, 0xf6, 0xec, 0xf7, 0xb5, 0x67, 0x3f, 0x6f, 0x3c, 0x6b, 0xb9,
+	0xf1, 0xac, 0xd7, 0x8d, 0x67, 0xdd, 0x9d, 0xff, 0xb0, 0xc9, 0xaa, 0x8c, 0x41, 0x93, 0xa2, 0x52,
+	0x12, 0x09, 0x96, 0x84, 0xa0, 0xb6, 0x7b, 0x37, 0xd6, 0x41, 0x2d, 0x6f, 0xec, 0xf4, 0x73, 0x00,
+	0x39, 0xd0, 0xa7, 0x34, 0x23, 0x02, 0x00, 0x00,
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCoinswapquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Liquidity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswapquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswapquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Standard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCoinswapquery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReserveAddress) > 0 {
+		i -= len(m.ReserveAddress)
+		copy(dAtA[i:], m.ReserveAddress)
+		i = encodeVarintCoinswapquery(dAtA, i, uint64(len(m.ReserveAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCoinswapquery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCoinswapquery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCoinswapquery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCoinswapquery(uint64(l))
+	}
+	l = len(m.ReserveAddress)
+	if l > 0 {
+		n += 1 + l + sovCoinswapquery(uint64(l))
+	}
+	l = m.Standard.Size()
+	n += 1 + l + sovCoinswapquery(uint64(l))
+	l = m.Token.Size()
+	n += 1 + l + sovCoinswapquery(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovCoinswapquery(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovCoinswapquery(uint64(l))
+	return n
+}
+
+func sovCoinswapquery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCoinswapquery(x uint64) (n int) {
+	return sovCoinswapquery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCoinswapquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswapquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswapquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Standard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswapquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Standard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswapquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswapquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCoinswapquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCoinswapquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCoinswapquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCoinswapquery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCoinswapquery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCoinswapquery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCoinswapquery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCoinswapquery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCoinswapquery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCoinswapquery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCoinswapquery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCoinswapquery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCoinswapquery = fmt.Errorf("proto: unexpected end of group")
+)
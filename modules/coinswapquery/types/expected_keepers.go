@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// CoinswapKeeper defines the expected coinswap keeper (noalias)
+type CoinswapKeeper interface {
+	GetStandardDenom(ctx sdk.Context) string
+	GetParams(ctx sdk.Context) coinswaptypes.Params
+	GetReservePool(ctx sdk.Context, uniDenom string) sdk.Coins
+	GetUniDenomFromDenoms(ctx sdk.Context, denom1, denom2 string) (string, error)
+}
+
+// BankKeeper defines the expected bank keeper (noalias)
+type BankKeeper interface {
+	GetSupply(ctx sdk.Context) bankexported.SupplyI
+}
@@ -0,0 +1,12 @@
+package types
+
+// nolint
+const (
+	// ModuleName defines the name of the coinswap queries
+	ModuleName = "coinswapquery"
+
+	// Query endpoints served on the querier route of the coinswap module
+	QueryPool         = "pool"
+	QueryPools        = "pools"
+	QueryEstimateSwap = "estimate_swap"
+)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coinswaptypes "github.com/irisnet/irismod/modules/coinswap/types"
+)
+
+// NewPool constructs a new Pool instance from the reserves, the liquidity and
+// the swap fee of the pool of the given token denom
+func NewPool(denom, standardDenom string, reserves sdk.Coins, liquidity sdk.Coin, fee sdk.Dec) Pool {
+	return Pool{
+		Denom:          denom,
+		ReserveAddress: coinswaptypes.GetReservePoolAddr(liquidity.Denom).String(),
+		Standard:       sdk.NewCoin(standardDenom, reserves.AmountOf(standardDenom)),
+		Token:          sdk.NewCoin(denom, reserves.AmountOf(denom)),
+		Liquidity:      liquidity,
+		Fee:            fee,
+	}
+}
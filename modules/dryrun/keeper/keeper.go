@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/dryrun/types"
+)
+
+// Keeper of the dryrun module
+type Keeper struct {
+	govKeeper types.GovKeeper
+}
+
+// NewKeeper returns a dryrun keeper
+func NewKeeper(govKeeper types.GovKeeper) Keeper {
+	return Keeper{
+		govKeeper: govKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// DryRunProposal executes the content of the given proposal the way gov does
+// when it passes, against a branch of the state that is discarded afterwards.
+// It returns the store entries and events the execution would produce; a
+// failing execution is reported in the response rather than as an error.
+func (k Keeper) DryRunProposal(ctx sdk.Context, proposalID uint64) (*types.QueryProposalResponse, error) {
+	proposal, found := k.govKeeper.GetProposal(ctx, proposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(govtypes.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	content := proposal.GetContent()
+	if content == nil {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidProposalContent, "proposal %d has no content", proposalID)
+	}
+
+	router := k.govKeeper.Router()
+	if !router.HasRoute(content.ProposalRoute()) {
+		return nil, sdkerrors.Wrap(govtypes.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+
+	ms := newRecordingMultiStore(ctx.MultiStore().CacheMultiStore())
+	runCtx := ctx.WithMultiStore(ms).WithEventManager(sdk.NewEventManager())
+
+	if err := router.GetRoute(content.ProposalRoute())(runCtx, content); err != nil {
+		return &types.QueryProposalResponse{Success: false, Error: err.Error()}, nil
+	}
+
+	return &types.QueryProposalResponse{
+		Success: true,
+		Changes: ms.Changes(),
+		Events:  types.NewEvents(runCtx.EventManager().Events()),
+	}, nil
+}
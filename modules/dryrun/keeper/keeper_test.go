@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/irisnet/irishub/modules/dryrun/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) submitProposal(content govtypes.Content) uint64 {
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+	suite.NoError(err)
+	return proposal.ProposalId
+}
+
+func (suite *KeeperTestSuite) TestDryRunProposal() {
+	key := string(stakingtypes.KeyMaxValidators)
+	maxValidators := suite.app.StakingKeeper.MaxValidators(suite.ctx)
+
+	proposalID := suite.submitProposal(proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		proposal.NewParamChange(stakingtypes.ModuleName, key, `200`),
+	}))
+
+	res, err := suite.app.DryRunKeeper.DryRunProposal(suite.ctx, proposalID)
+	suite.NoError(err)
+	suite.True(res.Success)
+	suite.Empty(res.Error)
+	suite.Contains(
+		res.Changes,
+		types.NewStoreChange(paramstypes.StoreKey, []byte(stakingtypes.ModuleName+"/"+key), []byte(`200`), false),
+	)
+
+	// the state is left untouched
+	suite.Equal(maxValidators, suite.app.StakingKeeper.MaxValidators(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestDryRunProposalFailed() {
+	// submitted proposals are validated by executing them, so store one that
+	// fails directly, as a later state change could make it fail
+	content := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), `"invalid"`),
+	})
+	proposalID := uint64(100)
+	submitted, err := govtypes.NewProposal(content, proposalID, suite.ctx.BlockTime(), suite.ctx.BlockTime())
+	suite.NoError(err)
+	suite.app.GovKeeper.SetProposal(suite.ctx, submitted)
+
+	res, err := suite.app.DryRunKeeper.DryRunProposal(suite.ctx, proposalID)
+	suite.NoError(err)
+	suite.False(res.Success)
+	suite.NotEmpty(res.Error)
+	suite.Empty(res.Changes)
+}
+
+func (suite *KeeperTestSuite) TestDryRunUnknownProposal() {
+	_, err := suite.app.DryRunKeeper.DryRunProposal(suite.ctx, 200)
+	suite.ErrorIs(err, govtypes.ErrUnknownProposal)
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/dryrun/types"
+)
+
+// recordingMultiStore records the entries written to the module stores of
+// the multi store it wraps.
+type recordingMultiStore struct {
+	sdk.MultiStore
+
+	changes map[string]types.StoreChange
+}
+
+func newRecordingMultiStore(ms sdk.MultiStore) *recordingMultiStore {
+	return &recordingMultiStore{
+		MultiStore: ms,
+		changes:    make(map[string]types.StoreChange),
+	}
+}
+
+// GetKVStore implements sdk.MultiStore. Transient and memory stores are
+// discarded at the end of each block and are not recorded.
+func (rs *recordingMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	store := rs.MultiStore.GetKVStore(key)
+	if _, ok := key.(*sdk.KVStoreKey); !ok {
+		return store
+	}
+	return recordingStore{KVStore: store, name: key.Name(), changes: rs.changes}
+}
+
+// CacheMultiStore implements sdk.MultiStore. The writes to the returned cache,
+// such as those of a ctx.CacheContext(), are recorded once it is written back,
+// so the writes of a discarded cache are not reported.
+func (rs *recordingMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	cms := rs.MultiStore.CacheMultiStore()
+	return &recordingCacheMultiStore{
+		recordingMultiStore: newRecordingMultiStore(cms),
+		cms:                 cms,
+		parent:              rs.changes,
+	}
+}
+
+// Changes returns the last change of each written entry, sorted by store and key
+func (rs *recordingMultiStore) Changes() []types.StoreChange {
+	changes := make([]types.StoreChange, 0, len(rs.changes))
+	for _, change := range rs.changes {
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Store != changes[j].Store {
+			return changes[i].Store < changes[j].Store
+		}
+		return bytes.Compare(changes[i].Key, changes[j].Key) < 0
+	})
+	return changes
+}
+
+// recordingCacheMultiStore records the entries written to a cache of a
+// recordingMultiStore, and hands them to its parent when written back.
+type recordingCacheMultiStore struct {
+	*recordingMultiStore
+
+	cms    sdk.CacheMultiStore
+	parent map[string]types.StoreChange
+}
+
+// Write implements sdk.CacheMultiStore
+func (rcs *recordingCacheMultiStore) Write() {
+	rcs.cms.Write()
+	for key, change := range rcs.changes {
+		rcs.parent[key] = change
+	}
+}
+
+// recordingStore records the entries set or deleted on the wrapped store
+type recordingStore struct {
+	sdk.KVStore
+
+	name    string
+	changes map[string]types.StoreChange
+}
+
+// Set implements sdk.KVStore
+func (s recordingStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	s.changes[s.name+"/"+string(key)] = types.NewStoreChange(s.name, key, value, false)
+}
+
+// Delete implements sdk.KVStore
+func (s recordingStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.changes[s.name+"/"+string(key)] = types.NewStoreChange(s.name, key, nil, true)
+}
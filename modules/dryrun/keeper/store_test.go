@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/dryrun/types"
+)
+
+func TestRecordingMultiStoreCacheContext(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+	ms := newRecordingMultiStore(ctx.MultiStore().CacheMultiStore())
+	runCtx := ctx.WithMultiStore(ms)
+
+	// a handler writing directly, through a written cache context, through
+	// a nested one and through a discarded one
+	handler := func(ctx sdk.Context) error {
+		ctx.KVStore(key).Set([]byte("direct"), []byte("1"))
+
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx.KVStore(key).Set([]byte("cached"), []byte("2"))
+		cacheCtx.KVStore(key).Delete([]byte("direct"))
+
+		nestedCtx, writeNested := cacheCtx.CacheContext()
+		nestedCtx.KVStore(key).Set([]byte("nested"), []byte("3"))
+		writeNested()
+		write()
+
+		discardedCtx, _ := ctx.CacheContext()
+		discardedCtx.KVStore(key).Set([]byte("discarded"), []byte("4"))
+		return nil
+	}
+	require.NoError(t, handler(runCtx))
+
+	require.Equal(t, []types.StoreChange{
+		types.NewStoreChange("test", []byte("cached"), []byte("2"), false),
+		types.NewStoreChange("test", []byte("direct"), nil, true),
+		types.NewStoreChange("test", []byte("nested"), []byte("3"), false),
+	}, ms.Changes())
+
+	// nothing reaches the underlying store
+	require.False(t, ctx.KVStore(key).Has([]byte("cached")))
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewStoreChange constructs a StoreChange
+func NewStoreChange(store string, key, value []byte, delete bool) StoreChange {
+	return StoreChange{
+		Store:  store,
+		Key:    key,
+		Value:  value,
+		Delete: delete,
+	}
+}
+
+// NewEvents converts the events emitted by a dry run
+func NewEvents(events sdk.Events) []Event {
+	res := make([]Event, 0, len(events))
+	for _, event := range events {
+		attributes := make([]Attribute, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attributes = append(attributes, Attribute{Key: string(attr.Key), Value: string(attr.Value)})
+		}
+		res = append(res, Event{Type: event.Type, Attributes: attributes})
+	}
+	return res
+}
@@ -0,0 +1,13 @@
+package types
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "dryrun"
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the dryrun querier
+	QueryProposal = "proposal"
+)
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/dustsweep/types"
+)
+
+// NewTxCmd returns the transaction commands for the dustsweep module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "dustsweep transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdOptIn(),
+		GetCmdOptOut(),
+	)
+	return txCmd
+}
+
+// GetCmdOptIn implements the opt-in command.
+func GetCmdOptIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opt-in",
+		Short: "Opt in to the sweep of the dust of an account into the community pool",
+		Long:  "Opt in to the sweep of the dust of the sender account into the community pool once it has signed no transaction for the blocks set by governance.",
+		Example: fmt.Sprintf(
+			"%s tx dustsweep opt-in --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOptIn(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdOptOut implements the opt-out command.
+func GetCmdOptOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opt-out",
+		Short: "Opt out of the sweep of the dust of an account",
+		Example: fmt.Sprintf(
+			"%s tx dustsweep opt-out --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOptOut(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
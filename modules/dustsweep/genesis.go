@@ -0,0 +1,39 @@
+package dustsweep
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/dustsweep/keeper"
+	"github.com/irisnet/irishub/modules/dustsweep/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize dustsweep genesis state: %s", err.Error()))
+	}
+
+	k.SetParamSet(ctx, data.Params)
+	for _, optIn := range data.OptIns {
+		k.SetOptIn(ctx, optIn)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var optIns []types.OptIn
+	k.IterateOptIns(ctx, func(optIn types.OptIn) bool {
+		optIns = append(optIns, optIn)
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParamSet(ctx), optIns)
+}
+
+// ValidateGenesis performs basic validation of dustsweep genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
@@ -0,0 +1,65 @@
+package dustsweep_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/dustsweep"
+	"github.com/irisnet/irishub/modules/dustsweep/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) TestExportGenesis() {
+	defaultGenesis := types.DefaultGenesisState()
+	exportedGenesis := dustsweep.ExportGenesis(suite.ctx, suite.app.DustSweepKeeper)
+	suite.Equal(defaultGenesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestInitGenesis() {
+	address := sdk.AccAddress([]byte("dustsweep-address---"))
+	genesis := types.NewGenesisState(
+		types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 10, 2),
+		[]types.OptIn{{Address: address.String(), LastActiveHeight: 5}},
+	)
+	dustsweep.InitGenesis(suite.ctx, suite.app.DustSweepKeeper, *genesis)
+
+	exportedGenesis := dustsweep.ExportGenesis(suite.ctx, suite.app.DustSweepKeeper)
+	suite.Equal(genesis, exportedGenesis)
+
+	// the imported opt-ins are queued by their last active height
+	suite.app.DustSweepKeeper.SweepInactive(suite.ctx.WithBlockHeight(15))
+	_, found := suite.app.DustSweepKeeper.GetOptIn(suite.ctx, address)
+	suite.False(found)
+}
+
+func (suite *TestSuite) TestValidateGenesis() {
+	optIn := types.OptIn{Address: sdk.AccAddress([]byte("dustsweep-address---")).String(), LastActiveHeight: 5}
+
+	suite.NoError(dustsweep.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), []types.OptIn{optIn})))
+	suite.Error(dustsweep.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), []types.OptIn{optIn, optIn})))
+	suite.Error(dustsweep.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), []types.OptIn{{Address: "invalid"}})))
+	suite.Error(dustsweep.ValidateGenesis(*types.NewGenesisState(types.NewParams(sdk.NewCoins(), -1, 2), nil)))
+}
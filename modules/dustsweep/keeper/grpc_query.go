@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/dustsweep/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the dustsweep parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// OptIn queries the opt-in of an account
+func (k Keeper) OptIn(c context.Context, req *types.QueryOptInRequest) (*types.QueryOptInResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	optIn, found := k.GetOptIn(ctx, address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNotOptedIn, req.Address)
+	}
+
+	return &types.QueryOptInResponse{OptIn: optIn}, nil
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/dustsweep/types"
+)
+
+// Keeper of the dustsweep store
+type Keeper struct {
+	cdc         codec.Marshaler
+	storeKey    sdk.StoreKey
+	paramSpace  paramtypes.Subspace
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistrKeeper
+}
+
+// NewKeeper returns a dustsweep keeper
+func NewKeeper(
+	cdc codec.Marshaler,
+	key sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+) Keeper {
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		paramSpace:  paramSpace.WithKeyTable(types.ParamKeyTable()),
+		bankKeeper:  bankKeeper,
+		distrKeeper: distrKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GetParamSet returns dustsweep params from the global param store.
+// The params may be absent on chains that added the module by an
+// upgrade, in which case the defaults apply.
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyDustThreshold, &params.DustThreshold)
+	k.paramSpace.GetIfExists(ctx, types.KeyInactiveBlocks, &params.InactiveBlocks)
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxSweepsPerBlock, &params.MaxSweepsPerBlock)
+	return params
+}
+
+// SetParamSet sets dustsweep params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// AddOptIn opts an account in to the sweep of its dust, which makes it active at
+// the current height
+func (k Keeper) AddOptIn(ctx sdk.Context, address sdk.AccAddress) {
+	k.DeleteOptIn(ctx, address)
+	k.SetOptIn(ctx, types.OptIn{Address: address.String(), LastActiveHeight: ctx.BlockHeight()})
+}
+
+// RemoveOptIn opts an account out of the sweep of its dust
+func (k Keeper) RemoveOptIn(ctx sdk.Context, address sdk.AccAddress) error {
+	if _, found := k.GetOptIn(ctx, address); !found {
+		return sdkerrors.Wrap(types.ErrNotOptedIn, address.String())
+	}
+	k.DeleteOptIn(ctx, address)
+	return nil
+}
+
+// RecordActivity records a transaction signed by an account at the current
+// height, which postpones the sweep of its dust if it opted in
+func (k Keeper) RecordActivity(ctx sdk.Context, address sdk.AccAddress) {
+	optIn, found := k.GetOptIn(ctx, address)
+	if !found || optIn.LastActiveHeight == ctx.BlockHeight() {
+		return
+	}
+	k.AddOptIn(ctx, address)
+}
+
+// SweepInactive sweeps the dust of the opted-in accounts inactive for the set
+// number of blocks into the community pool, the least recently active first
+// and up to the set number of accounts per block. The opt-in of a swept account
+// ends with the sweep.
+func (k Keeper) SweepInactive(ctx sdk.Context) {
+	params := k.GetParamSet(ctx)
+	if !params.IsEnabled() || ctx.BlockHeight() < params.InactiveBlocks {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ActivityKey, types.GetActivityHeightKey(ctx.BlockHeight()-params.InactiveBlocks+1))
+	defer iterator.Close()
+
+	var addresses []sdk.AccAddress
+	for ; iterator.Valid() && len(addresses) < int(params.MaxSweepsPerBlock); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(iterator.Value()))
+	}
+
+	for _, address := range addresses {
+		swept := k.sweep(ctx, params, address)
+		k.DeleteOptIn(ctx, address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSweepDust,
+				sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, swept.String()),
+			),
+		)
+	}
+}
+
+// sweep sends the spendable dust of an account to the community pool. If the
+// send fails, nothing is swept.
+func (k Keeper) sweep(ctx sdk.Context, params types.Params, address sdk.AccAddress) sdk.Coins {
+	dust := params.Dust(k.bankKeeper.SpendableCoins(ctx, address))
+	if dust.IsZero() {
+		return dust
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.distrKeeper.FundCommunityPool(cacheCtx, dust, address); err != nil {
+		k.Logger(ctx).Error("failed to sweep the dust", "address", address.String(), "err", err)
+		return sdk.NewCoins()
+	}
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeCache()
+	return dust
+}
+
+// GetOptIn returns the opt-in of the given address
+func (k Keeper) GetOptIn(ctx sdk.Context, address sdk.AccAddress) (optIn types.OptIn, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetOptInKey(address))
+	if bz == nil {
+		return optIn, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &optIn)
+	return optIn, true
+}
+
+// SetOptIn stores the opt-in of an address and queues it by its last active height
+func (k Keeper) SetOptIn(ctx sdk.Context, optIn types.OptIn) {
+	address, err := sdk.AccAddressFromBech32(optIn.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&optIn)
+	store.Set(types.GetOptInKey(address), bz)
+	store.Set(types.GetActivityKey(optIn.LastActiveHeight, address), address.Bytes())
+}
+
+// DeleteOptIn deletes the opt-in of the given address, if any
+func (k Keeper) DeleteOptIn(ctx sdk.Context, address sdk.AccAddress) {
+	optIn, found := k.GetOptIn(ctx, address)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOptInKey(address))
+	store.Delete(types.GetActivityKey(optIn.LastActiveHeight, address))
+}
+
+// IterateOptIns iterates through all the opt-ins
+func (k Keeper) IterateOptIns(ctx sdk.Context, op func(optIn types.OptIn) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.OptInKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var optIn types.OptIn
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &optIn)
+
+		if stop := op(optIn); stop {
+			break
+		}
+	}
+}
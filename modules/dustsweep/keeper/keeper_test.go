@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/dustsweep/keeper"
+	"github.com/irisnet/irishub/modules/dustsweep/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("dustsweep-address-1-"))
+	addr2 = sdk.AccAddress([]byte("dustsweep-address-2-"))
+	addr3 = sdk.AccAddress([]byte("dustsweep-address-3-"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) fund(address sdk.AccAddress, coins sdk.Coins) {
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, address, coins))
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	suite.Equal(types.DefaultParams(), suite.app.DustSweepKeeper.GetParamSet(suite.ctx))
+	suite.False(types.DefaultParams().IsEnabled())
+
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 10, 2)
+	suite.app.DustSweepKeeper.SetParamSet(suite.ctx, params)
+	suite.Equal(params, suite.app.DustSweepKeeper.GetParamSet(suite.ctx))
+
+	res, err := suite.app.DustSweepKeeper.Params(sdk.WrapSDKContext(suite.ctx), &types.QueryParamsRequest{})
+	suite.NoError(err)
+	suite.Equal(params, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestOptIn() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.DustSweepKeeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.OptOut(ctx, types.NewMsgOptOut(addr1))
+	suite.ErrorIs(err, types.ErrNotOptedIn)
+
+	_, err = msgServer.OptIn(ctx, types.NewMsgOptIn(addr1))
+	suite.NoError(err)
+	res, err := suite.app.DustSweepKeeper.OptIn(ctx, &types.QueryOptInRequest{Address: addr1.String()})
+	suite.NoError(err)
+	suite.Equal(types.OptIn{Address: addr1.String(), LastActiveHeight: suite.ctx.BlockHeight()}, res.OptIn)
+
+	_, err = msgServer.OptOut(ctx, types.NewMsgOptOut(addr1))
+	suite.NoError(err)
+	_, err = suite.app.DustSweepKeeper.OptIn(ctx, &types.QueryOptInRequest{Address: addr1.String()})
+	suite.ErrorIs(err, types.ErrNotOptedIn)
+}
+
+func (suite *KeeperTestSuite) TestSweepInactive() {
+	denom := sdk.DefaultBondDenom
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin("dust", 10)), 10, 2)
+	suite.app.DustSweepKeeper.SetParamSet(suite.ctx, params)
+
+	suite.fund(addr1, sdk.NewCoins(sdk.NewInt64Coin(denom, 99), sdk.NewInt64Coin("dust", 10), sdk.NewInt64Coin("other", 1)))
+	suite.fund(addr2, sdk.NewCoins(sdk.NewInt64Coin(denom, 50)))
+	suite.fund(addr3, sdk.NewCoins(sdk.NewInt64Coin(denom, 50)))
+
+	// addr3 does not opt in, addr2 is active later
+	suite.app.DustSweepKeeper.AddOptIn(suite.ctx, addr1)
+	suite.app.DustSweepKeeper.AddOptIn(suite.ctx, addr2)
+	suite.app.DustSweepKeeper.RecordActivity(suite.ctx.WithBlockHeight(5), addr2)
+	suite.app.DustSweepKeeper.RecordActivity(suite.ctx.WithBlockHeight(5), addr3)
+
+	poolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+	// nothing is swept before the inactive blocks pass
+	suite.app.DustSweepKeeper.SweepInactive(suite.ctx.WithBlockHeight(10))
+	_, found := suite.app.DustSweepKeeper.GetOptIn(suite.ctx, addr1)
+	suite.True(found)
+
+	suite.app.DustSweepKeeper.SweepInactive(suite.ctx.WithBlockHeight(11))
+	_, found = suite.app.DustSweepKeeper.GetOptIn(suite.ctx, addr1)
+	suite.False(found)
+	suite.Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("dust", 10), sdk.NewInt64Coin("other", 1)),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1),
+	)
+	suite.Equal(
+		poolBefore.Add(sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin(denom, 99))...),
+		suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx),
+	)
+
+	_, found = suite.app.DustSweepKeeper.GetOptIn(suite.ctx, addr2)
+	suite.True(found)
+	suite.app.DustSweepKeeper.SweepInactive(suite.ctx.WithBlockHeight(15))
+	suite.True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr2).IsZero())
+	suite.Equal(int64(50), suite.app.BankKeeper.GetBalance(suite.ctx, addr3, denom).Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestMaxSweepsPerBlock() {
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 10, 2)
+	suite.app.DustSweepKeeper.SetParamSet(suite.ctx, params)
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2, addr3} {
+		suite.app.DustSweepKeeper.AddOptIn(suite.ctx, addr)
+	}
+
+	suite.app.DustSweepKeeper.SweepInactive(suite.ctx.WithBlockHeight(11))
+	var remaining int
+	suite.app.DustSweepKeeper.IterateOptIns(suite.ctx, func(types.OptIn) bool {
+		remaining++
+		return false
+	})
+	suite.Equal(1, remaining)
+}
@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dustsweep/dustsweep.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines dustsweep module's parameters
+type Params struct {
+	// balances below the amount of their denom are dust, balances of other denoms never are
+	DustThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=dust_threshold,json=dustThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dust_threshold" yaml:"dust_threshold"`
+	// blocks without a transaction signed by an opted-in account after which its dust is swept, 0 disables the sweeps
+	InactiveBlocks int64 `protobuf:"varint,2,opt,name=inactive_blocks,json=inactiveBlocks,proto3" json:"inactive_blocks,omitempty" yaml:"inactive_blocks"`
+	// maximum accounts swept per block
+	MaxSweepsPerBlock uint32 `protobuf:"varint,3,opt,name=max_sweeps_per_block,json=maxSweepsPerBlock,proto3" json:"max_sweeps_per_block,omitempty" yaml:"max_sweeps_per_block"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3dfc85753549985d, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDustThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DustThreshold
+	}
+	return nil
+}
+
+func (m *Params) GetInactiveBlocks() int64 {
+	if m != nil {
+		return m.InactiveBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSweepsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxSweepsPerBlock
+	}
+	return 0
+}
+
+// OptIn defines an account opted in to the sweep of its dust
+type OptIn struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height of the last transaction signed by the account since it opted in
+	LastActiveHeight int64 `protobuf:"varint,2,opt,name=last_active_height,json=lastActiveHeight,proto3" json:"last_active_height,omitempty" yaml:"last_active_height"`
+}
+
+func (m *OptIn) Reset()         { *m = OptIn{} }
+func (m *OptIn) String() string { return proto.CompactTextString(m) }
+func (*OptIn) ProtoMessage()    {}
+func (*OptIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3dfc85753549985d, []int{1}
+}
+func (m *OptIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptIn.Merge(m, src)
+}
+func (m *OptIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptIn proto.InternalMessageInfo
+
+func (m *OptIn) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *OptIn) GetLastActiveHeight() int64 {
+	if m != nil {
+		return m.LastActiveHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "irishub.dustsweep.Params")
+	proto.RegisterType((*OptIn)(nil), "irishub.dustsweep.OptIn")
+}
+
+func init() { proto.RegisterFile("dustsweep/dustsweep.proto", fileDescriptor_3dfc85753549985d) }
+
+var fileDescriptor_3dfc85753549985d = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0x6e, 0xd4, 0x40,
+	0x10, 0xc6, 0xbd, 0x39, 0x08, 0x62, 0x51, 0x02, 0xb1, 0x02, 0xf2, 0x1d, 0xc2, 0x7b, 0x72, 0xe5,
+	0x06, 0xaf, 0x12, 0xba, 0x74, 0x38, 0x0d, 0x11, 0x48, 0x9c, 0x0c, 0x15, 0x8d, 0xb5, 0xb6, 0x57,
+	0xf6, 0x2a, 0xb6, 0xd7, 0xf2, 0xec, 0x85, 0xe4, 0x19, 0x68, 0x28, 0x29, 0xa9, 0x69, 0x79, 0x89,
+	0x94, 0x29, 0xa9, 0x0c, 0xba, 0x7b, 0x03, 0x3f, 0x01, 0xf2, 0xae, 0xef, 0xf8, 0x5b, 0x79, 0xfc,
+	0xf9, 0xfb, 0x7e, 0x3b, 0x9e, 0x1d, 0x3c, 0xcd, 0x96, 0xa0, 0xe0, 0x3d, 0xe7, 0x0d, 0xdd, 0x56,
+	0x41, 0xd3, 0x4a, 0x25, 0xed, 0x03, 0xd1, 0x0a, 0x28, 0x96, 0x49, 0xb0, 0xfd, 0x30, 0x73, 0x53,
+	0x09, 0x95, 0x04, 0x9a, 0x30, 0xe0, 0xf4, 0xe2, 0x28, 0xe1, 0x8a, 0x1d, 0xd1, 0x54, 0x8a, 0xda,
+	0x44, 0x66, 0x87, 0xb9, 0xcc, 0xa5, 0x2e, 0xe9, 0x50, 0x19, 0xd5, 0xfb, 0xba, 0x83, 0x77, 0x17,
+	0xac, 0x65, 0x15, 0xd8, 0x1f, 0x10, 0xde, 0x1f, 0x70, 0xb1, 0x2a, 0x5a, 0x0e, 0x85, 0x2c, 0x33,
+	0x07, 0xcd, 0x27, 0xfe, 0xbd, 0xe3, 0x69, 0x60, 0xd0, 0xc1, 0x80, 0x0e, 0x46, 0x74, 0x70, 0x2a,
+	0x45, 0x1d, 0x9e, 0x5d, 0x77, 0xc4, 0xea, 0x3b, 0xf2, 0xf0, 0x8a, 0x55, 0xe5, 0x89, 0xf7, 0x67,
+	0xdc, 0xfb, 0xf2, 0x9d, 0xf8, 0xb9, 0x50, 0x43, 0x9f, 0xa9, 0xac, 0xe8, 0xd8, 0xa0, 0x79, 0x3c,
+	0x85, 0xec, 0x9c, 0xaa, 0xab, 0x86, 0x83, 0x26, 0x41, 0xb4, 0x37, 0x84, 0xdf, 0x6e, 0xb2, 0xf6,
+	0x29, 0xbe, 0x2f, 0x6a, 0x96, 0x2a, 0x71, 0xc1, 0xe3, 0xa4, 0x94, 0xe9, 0x39, 0x38, 0x3b, 0x73,
+	0xe4, 0x4f, 0xc2, 0x59, 0xdf, 0x91, 0x47, 0xe6, 0xb8, 0xbf, 0x0c, 0x5e, 0xb4, 0xbf, 0x51, 0x42,
+	0x2d, 0xd8, 0x0b, 0x7c, 0x58, 0xb1, 0xcb, 0x58, 0x0f, 0x08, 0xe2, 0x86, 0xb7, 0xc6, 0xe9, 0x4c,
+	0xe6, 0xc8, 0xdf, 0x0b, 0x49, 0xdf, 0x91, 0xc7, 0x86, 0xf4, 0x3f, 0x97, 0x17, 0x1d, 0x54, 0xec,
+	0xf2, 0x8d, 0x56, 0x17, 0xbc, 0xd5, 0xc8, 0x93, 0x5b, 0x9f, 0x3e, 0x13, 0xcb, 0xab, 0xf1, 0xed,
+	0xd7, 0x8d, 0x3a, 0xab, 0x6d, 0x07, 0xdf, 0x61, 0x59, 0xd6, 0x72, 0x00, 0x07, 0xcd, 0x91, 0x7f,
+	0x37, 0xda, 0xbc, 0xda, 0x2f, 0xb1, 0x5d, 0x32, 0x50, 0xf1, 0xd8, 0x61, 0xc1, 0x45, 0x5e, 0xa8,
+	0xf1, 0x17, 0x9e, 0xf4, 0x1d, 0x99, 0x9a, 0x83, 0xff, 0xf5, 0x78, 0xd1, 0x83, 0x41, 0x7c, 0xae,
+	0xb5, 0x17, 0x5a, 0x0a, 0x5f, 0x5d, 0xaf, 0x5c, 0x74, 0xb3, 0x72, 0xd1, 0x8f, 0x95, 0x8b, 0x3e,
+	0xae, 0x5d, 0xeb, 0x66, 0xed, 0x5a, 0xdf, 0xd6, 0xae, 0xf5, 0xee, 0xf8, 0xb7, 0xf9, 0x0e, 0x3b,
+	0x51, 0x73, 0x45, 0xc7, 0xdd, 0xa0, 0x95, 0xcc, 0x96, 0x25, 0x87, 0x5f, 0xcb, 0x63, 0xe6, 0x9d,
+	0xec, 0xea, 0xab, 0x7f, 0xf6, 0x73, 0x00, 0x86, 0x1d, 0x15, 0xe9, 0x60, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSweepsPerBlock != 0 {
+		i = encodeVarintDustsweep(dAtA, i, uint64(m.MaxSweepsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InactiveBlocks != 0 {
+		i = encodeVarintDustsweep(dAtA, i, uint64(m.InactiveBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DustThreshold) > 0 {
+		for iNdEx := len(m.DustThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDustsweep(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastActiveHeight != 0 {
+		i = encodeVarintDustsweep(dAtA, i, uint64(m.LastActiveHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDustsweep(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDustsweep(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDustsweep(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DustThreshold) > 0 {
+		for _, e := range m.DustThreshold {
+			l = e.Size()
+			n += 1 + l + sovDustsweep(uint64(l))
+		}
+	}
+	if m.InactiveBlocks != 0 {
+		n += 1 + sovDustsweep(uint64(m.InactiveBlocks))
+	}
+	if m.MaxSweepsPerBlock != 0 {
+		n += 1 + sovDustsweep(uint64(m.MaxSweepsPerBlock))
+	}
+	return n
+}
+
+func (m *OptIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDustsweep(uint64(l))
+	}
+	if m.LastActiveHeight != 0 {
+		n += 1 + sovDustsweep(uint64(m.LastActiveHeight))
+	}
+	return n
+}
+
+func sovDustsweep(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDustsweep(x uint64) (n int) {
+	return sovDustsweep(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDustsweep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDustsweep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDustsweep
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDustsweep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThreshold = append(m.DustThreshold, types.Coin{})
+			if err := m.DustThreshold[len(m.DustThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveBlocks", wireType)
+			}
+			m.InactiveBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDustsweep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactiveBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSweepsPerBlock", wireType)
+			}
+			m.MaxSweepsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDustsweep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSweepsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDustsweep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDustsweep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDustsweep
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDustsweep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDustsweep
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDustsweep
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActiveHeight", wireType)
+			}
+			m.LastActiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDustsweep
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastActiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDustsweep(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDustsweep
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDustsweep(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDustsweep
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDustsweep
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDustsweep
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDustsweep
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDustsweep
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDustsweep
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDustsweep        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDustsweep          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDustsweep = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// dustsweep module sentinel errors
+var (
+	ErrNotOptedIn = sdkerrors.Register(ModuleName, 2, "account not opted in")
+)
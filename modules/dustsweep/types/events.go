@@ -0,0 +1,14 @@
+// nolint
+package types
+
+// dustsweep module event types
+const (
+	EventTypeOptIn     = "opt_in"
+	EventTypeOptOut    = "opt_out"
+	EventTypeSweepDust = "sweep_dust"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyAmount  = "amount"
+
+	AttributeValueCategory = ModuleName
+)
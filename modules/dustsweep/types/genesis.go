@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(params Params, optIns []OptIn) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		OptIns: optIns,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided dustsweep genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, optIn := range data.OptIns {
+		if _, err := sdk.AccAddressFromBech32(optIn.Address); err != nil {
+			return fmt.Errorf("invalid opt-in address %s: %w", optIn.Address, err)
+		}
+		if optIn.LastActiveHeight < 0 {
+			return fmt.Errorf("invalid last active height of %s: %d", optIn.Address, optIn.LastActiveHeight)
+		}
+		if seen[optIn.Address] {
+			return fmt.Errorf("duplicate opt-in %s", optIn.Address)
+		}
+		seen[optIn.Address] = true
+	}
+	return nil
+}
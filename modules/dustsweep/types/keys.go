@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "dustsweep"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the dustsweep querier
+	QueryParameters = "parameters"
+	QueryOptIn      = "opt_in"
+)
+
+var (
+	// Keys for store prefixes
+	OptInKey    = []byte{0x01} // prefix for the opt-in of each address
+	ActivityKey = []byte{0x02} // prefix for the opted-in addresses by their last active height
+)
+
+// GetOptInKey returns the key of the opt-in of the given address
+func GetOptInKey(address sdk.AccAddress) []byte {
+	return append(OptInKey, address.Bytes()...)
+}
+
+// GetActivityKey returns the key of an opted-in address in the activity queue
+func GetActivityKey(height int64, address sdk.AccAddress) []byte {
+	return append(GetActivityHeightKey(height), address.Bytes()...)
+}
+
+// GetActivityHeightKey returns the prefix of the opted-in addresses last active at the given height
+func GetActivityHeightKey(height int64) []byte {
+	return append(append([]byte{}, ActivityKey...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgOptIn  = "opt_in"  // type for MsgOptIn
+	TypeMsgOptOut = "opt_out" // type for MsgOptOut
+)
+
+var (
+	_ sdk.Msg = &MsgOptIn{}
+	_ sdk.Msg = &MsgOptOut{}
+)
+
+// NewMsgOptIn constructs a MsgOptIn
+func NewMsgOptIn(address sdk.AccAddress) *MsgOptIn {
+	return &MsgOptIn{
+		Address: address.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgOptIn) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgOptIn) Type() string { return TypeMsgOptIn }
+
+// GetSignBytes implements Msg.
+func (msg MsgOptIn) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgOptIn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgOptIn) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgOptOut constructs a MsgOptOut
+func NewMsgOptOut(address sdk.AccAddress) *MsgOptOut {
+	return &MsgOptOut{
+		Address: address.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgOptOut) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgOptOut) Type() string { return TypeMsgOptOut }
+
+// GetSignBytes implements Msg.
+func (msg MsgOptOut) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgOptOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgOptOut) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store keys
+var (
+	KeyDustThreshold     = []byte("DustThreshold")
+	KeyInactiveBlocks    = []byte("InactiveBlocks")
+	KeyMaxSweepsPerBlock = []byte("MaxSweepsPerBlock")
+)
+
+// ParamKeyTable for dustsweep module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs Params
+func NewParams(dustThreshold sdk.Coins, inactiveBlocks int64, maxSweepsPerBlock uint32) Params {
+	return Params{
+		DustThreshold:     dustThreshold,
+		InactiveBlocks:    inactiveBlocks,
+		MaxSweepsPerBlock: maxSweepsPerBlock,
+	}
+}
+
+// DefaultParams returns default dustsweep module parameters. Nothing is swept
+// until governance sets the inactive blocks and the dust threshold.
+func DefaultParams() Params {
+	return NewParams(nil, 0, 100)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDustThreshold, &p.DustThreshold, validateDustThreshold),
+		paramtypes.NewParamSetPair(KeyInactiveBlocks, &p.InactiveBlocks, validateInactiveBlocks),
+		paramtypes.NewParamSetPair(KeyMaxSweepsPerBlock, &p.MaxSweepsPerBlock, validateMaxSweepsPerBlock),
+	}
+}
+
+// GetParamSpace implements params.ParamStruct
+func (p *Params) GetParamSpace() string {
+	return DefaultParamSpace
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateDustThreshold(p.DustThreshold); err != nil {
+		return err
+	}
+	if err := validateInactiveBlocks(p.InactiveBlocks); err != nil {
+		return err
+	}
+	return validateMaxSweepsPerBlock(p.MaxSweepsPerBlock)
+}
+
+// IsEnabled returns true if the dust of the inactive accounts is swept
+func (p Params) IsEnabled() bool {
+	return p.InactiveBlocks > 0 && !p.DustThreshold.Empty() && p.MaxSweepsPerBlock > 0
+}
+
+// Dust returns the balances below the dust threshold of their denom
+func (p Params) Dust(balances sdk.Coins) sdk.Coins {
+	dust := sdk.NewCoins()
+	for _, coin := range balances {
+		if coin.Amount.LT(p.DustThreshold.AmountOf(coin.Denom)) {
+			dust = dust.Add(coin)
+		}
+	}
+	return dust
+}
+
+func validateDustThreshold(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid dust threshold [%s]", v)
+	}
+	return nil
+}
+
+func validateInactiveBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("inactive blocks must not be negative: %d", v)
+	}
+	return nil
+}
+
+func validateMaxSweepsPerBlock(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/escrow/types"
+)
+
+// GetQueryCmd returns the cli query commands for the escrow module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the escrow module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryAccounts(),
+		GetCmdQueryAccount(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryAccounts implements a command to return the balances and obligations of all the module escrow accounts.
+func GetCmdQueryAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accounts",
+		Short:   "Query the balances of the module escrow accounts against their obligations",
+		Example: fmt.Sprintf("%s query escrow accounts", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Accounts(context.Background(), &types.QueryAccountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAccount implements a command to return the balance and obligations of a module escrow account.
+func GetCmdQueryAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account [name]",
+		Short: "Query the balance of a module escrow account against its obligations",
+		Long: `Query the balance of a module escrow account, the obligations it is expected
+to cover and the resulting surplus or deficit. The escrow accounts are
+distribution, gov, service_deposit_account, service_request_account and htlc.`,
+		Example: fmt.Sprintf("%s query escrow account gov", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Account(context.Background(), &types.QueryAccountRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/escrow/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Accounts queries the balances and obligations of all the module escrow accounts
+func (k Keeper) Accounts(c context.Context, _ *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAccountsResponse{Accounts: k.GetEscrowAccounts(ctx)}, nil
+}
+
+// Account queries the balance and obligations of a module escrow account
+func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	account, err := k.GetEscrowAccount(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountResponse{Account: account}, nil
+}
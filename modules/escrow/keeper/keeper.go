@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"fmt"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+
+	"github.com/irisnet/irishub/modules/escrow/types"
+)
+
+// Keeper of the escrow module
+type Keeper struct {
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+	govKeeper     types.GovKeeper
+	serviceKeeper types.ServiceKeeper
+	htlcKeeper    types.HTLCKeeper
+}
+
+// NewKeeper returns an escrow keeper
+func NewKeeper(
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	govKeeper types.GovKeeper,
+	serviceKeeper types.ServiceKeeper,
+	htlcKeeper types.HTLCKeeper,
+) Keeper {
+	return Keeper{
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		govKeeper:     govKeeper,
+		serviceKeeper: serviceKeeper,
+		htlcKeeper:    htlcKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// EscrowAccountNames returns the names of the module accounts holding funds on behalf of others
+func EscrowAccountNames() []string {
+	return []string{
+		distrtypes.ModuleName,
+		govtypes.ModuleName,
+		servicetypes.DepositAccName,
+		servicetypes.RequestAccName,
+		htlctypes.ModuleName,
+	}
+}
+
+// GetEscrowAccounts returns the balances and obligations of all the module escrow accounts
+func (k Keeper) GetEscrowAccounts(ctx sdk.Context) []types.EscrowAccount {
+	names := EscrowAccountNames()
+	accounts := make([]types.EscrowAccount, 0, len(names))
+	for _, name := range names {
+		account, _ := k.GetEscrowAccount(ctx, name)
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// GetEscrowAccount returns the balance of the given module escrow account
+// along with the obligations it is expected to cover, computed from the state
+// of the module owning it. These are the amounts checked by the invariants of
+// the module, a deficit means the account can not honor all its obligations.
+func (k Keeper) GetEscrowAccount(ctx sdk.Context, name string) (types.EscrowAccount, error) {
+	var obligations []types.Obligation
+	switch name {
+	case distrtypes.ModuleName:
+		obligations = k.distributionObligations(ctx)
+	case govtypes.ModuleName:
+		obligations = k.govObligations(ctx)
+	case servicetypes.DepositAccName:
+		obligations = k.serviceDepositObligations(ctx)
+	case servicetypes.RequestAccName:
+		obligations = k.serviceRequestObligations(ctx)
+	case htlctypes.ModuleName:
+		obligations = k.htlcObligations(ctx)
+	default:
+		return types.EscrowAccount{}, sdkerrors.Wrap(types.ErrUnknownAccount, name)
+	}
+
+	address := k.accountKeeper.GetModuleAddress(name)
+	return types.NewEscrowAccount(name, address, k.bankKeeper.GetAllBalances(ctx, address), obligations), nil
+}
+
+// distributionObligations returns the rewards not yet withdrawn from the
+// validators and the community pool. Both are tracked with decimals, so the
+// truncated amounts may leave a surplus of dust.
+func (k Keeper) distributionObligations(ctx sdk.Context) []types.Obligation {
+	rewards := sdk.NewDecCoins()
+	k.distrKeeper.IterateValidatorOutstandingRewards(ctx, func(_ sdk.ValAddress, outstanding distrtypes.ValidatorOutstandingRewards) bool {
+		rewards = rewards.Add(outstanding.Rewards...)
+		return false
+	})
+
+	unclaimedRewards, _ := rewards.TruncateDecimal()
+	communityPool, _ := k.distrKeeper.GetFeePoolCommunityCoins(ctx).TruncateDecimal()
+
+	return []types.Obligation{
+		types.NewObligation(types.ObligationUnclaimedRewards, unclaimedRewards),
+		types.NewObligation(types.ObligationCommunityPool, communityPool),
+	}
+}
+
+// govObligations returns the deposits of the proposals not yet refunded or burned
+func (k Keeper) govObligations(ctx sdk.Context) []types.Obligation {
+	deposits := sdk.NewCoins()
+	k.govKeeper.IterateAllDeposits(ctx, func(deposit govtypes.Deposit) bool {
+		deposits = deposits.Add(deposit.Amount...)
+		return false
+	})
+
+	return []types.Obligation{
+		types.NewObligation(types.ObligationProposalDeposits, deposits),
+	}
+}
+
+// serviceDepositObligations returns the deposits of the service bindings
+func (k Keeper) serviceDepositObligations(ctx sdk.Context) []types.Obligation {
+	deposits := sdk.NewCoins()
+	k.serviceKeeper.IterateServiceBindings(ctx, func(binding servicetypes.ServiceBinding) bool {
+		deposits = deposits.Add(binding.Deposit...)
+		return false
+	})
+
+	return []types.Obligation{
+		types.NewObligation(types.ObligationServiceDeposits, deposits),
+	}
+}
+
+// serviceRequestObligations returns the fees of the requests awaiting a
+// response, which are refunded to the consumers on timeout, and the fees
+// earned by the providers but not yet withdrawn by their owners
+func (k Keeper) serviceRequestObligations(ctx sdk.Context) []types.Obligation {
+	outstandingFees := sdk.NewCoins()
+	k.serviceKeeper.IterateRequestContexts(ctx, func(requestContextID tmbytes.HexBytes, requestContext servicetypes.RequestContext) bool {
+		k.serviceKeeper.IterateActiveRequests(ctx, requestContextID, requestContext.BatchCounter, func(_ tmbytes.HexBytes, request servicetypes.Request) {
+			outstandingFees = outstandingFees.Add(request.ServiceFee...)
+		})
+		return false
+	})
+
+	// the earned fees are tracked by provider and by owner, count the owners once
+	owners := make(map[string]bool)
+	unclaimedFees := sdk.NewCoins()
+	k.serviceKeeper.IterateServiceBindings(ctx, func(binding servicetypes.ServiceBinding) bool {
+		if owners[binding.Owner] {
+			return false
+		}
+		owners[binding.Owner] = true
+
+		owner, err := sdk.AccAddressFromBech32(binding.Owner)
+		if err != nil {
+			return false
+		}
+		fees, _ := k.serviceKeeper.GetOwnerEarnedFees(ctx, owner)
+		unclaimedFees = unclaimedFees.Add(fees...)
+		return false
+	})
+
+	return []types.Obligation{
+		types.NewObligation(types.ObligationOutstandingFees, outstandingFees),
+		types.NewObligation(types.ObligationUnclaimedFees, unclaimedFees),
+	}
+}
+
+// htlcObligations returns the amounts locked in the open HTLCs, claimable
+// by their recipients, and in the expired ones, refundable to their senders
+func (k Keeper) htlcObligations(ctx sdk.Context) []types.Obligation {
+	open, refundable := sdk.NewCoins(), sdk.NewCoins()
+	k.htlcKeeper.IterateHTLCs(ctx, func(_ tmbytes.HexBytes, htlc htlctypes.HTLC) bool {
+		switch htlc.State {
+		case htlctypes.Open:
+			open = open.Add(htlc.Amount...)
+		case htlctypes.Expired:
+			refundable = refundable.Add(htlc.Amount...)
+		}
+		return false
+	})
+
+	return []types.Obligation{
+		types.NewObligation(types.ObligationOpenHTLCs, open),
+		types.NewObligation(types.ObligationRefundableHTLCs, refundable),
+	}
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/escrow/keeper"
+	"github.com/irisnet/irishub/modules/escrow/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestGetEscrowAccounts() {
+	accounts := suite.app.EscrowKeeper.GetEscrowAccounts(suite.ctx)
+	suite.Len(accounts, len(keeper.EscrowAccountNames()))
+
+	for _, account := range accounts {
+		suite.Empty(account.Deficit, account.Name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetEscrowAccountGov() {
+	addrs := simapp.AddTestAddrs(suite.app, suite.ctx, 1, sdk.NewInt(10000000))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, govtypes.NewTextProposal("title", "description"))
+	suite.NoError(err)
+	_, err = suite.app.GovKeeper.AddDeposit(suite.ctx, proposal.ProposalId, addrs[0], deposit)
+	suite.NoError(err)
+
+	account, err := suite.app.EscrowKeeper.GetEscrowAccount(suite.ctx, govtypes.ModuleName)
+	suite.NoError(err)
+	suite.Equal(authtypes.NewModuleAddress(govtypes.ModuleName).String(), account.Address)
+	suite.Equal(deposit, account.Balance)
+	suite.Equal([]types.Obligation{types.NewObligation(types.ObligationProposalDeposits, deposit)}, account.Obligations)
+	suite.Empty(account.Surplus)
+	suite.Empty(account.Deficit)
+
+	// coins sent to the account directly are not owed to anyone
+	extra := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, addrs[0], govtypes.ModuleName, extra))
+
+	account, err = suite.app.EscrowKeeper.GetEscrowAccount(suite.ctx, govtypes.ModuleName)
+	suite.NoError(err)
+	suite.Equal(extra, account.Surplus)
+	suite.Empty(account.Deficit)
+}
+
+func (suite *KeeperTestSuite) TestGetEscrowAccountUnknown() {
+	_, err := suite.app.EscrowKeeper.GetEscrowAccount(suite.ctx, authtypes.FeeCollectorName)
+	suite.ErrorIs(err, types.ErrUnknownAccount)
+}
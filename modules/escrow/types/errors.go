@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// escrow module sentinel errors
+var (
+	ErrUnknownAccount = sdkerrors.Register(ModuleName, 2, "unknown escrow account")
+)
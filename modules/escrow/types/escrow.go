@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// kinds of the obligations of the module escrow accounts
+const (
+	ObligationCommunityPool    = "community_pool"
+	ObligationUnclaimedRewards = "unclaimed_rewards"
+	ObligationProposalDeposits = "proposal_deposits"
+	ObligationServiceDeposits  = "service_deposits"
+	ObligationOutstandingFees  = "outstanding_request_fees"
+	ObligationUnclaimedFees    = "unclaimed_service_fees"
+	ObligationOpenHTLCs        = "open_htlcs"
+	ObligationRefundableHTLCs  = "refundable_htlcs"
+)
+
+// NewObligation constructs an Obligation
+func NewObligation(kind string, amount sdk.Coins) Obligation {
+	return Obligation{
+		Kind:   kind,
+		Amount: amount,
+	}
+}
+
+// NewEscrowAccount constructs an EscrowAccount, computing the surplus and the
+// deficit of the balance against the total of the obligations
+func NewEscrowAccount(name string, address sdk.AccAddress, balance sdk.Coins, obligations []Obligation) EscrowAccount {
+	total := sdk.NewCoins()
+	for _, obligation := range obligations {
+		total = total.Add(obligation.Amount...)
+	}
+
+	surplus, deficit := sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range balance.Add(total...) {
+		diff := balance.AmountOf(coin.Denom).Sub(total.AmountOf(coin.Denom))
+		switch {
+		case diff.IsPositive():
+			surplus = surplus.Add(sdk.NewCoin(coin.Denom, diff))
+		case diff.IsNegative():
+			deficit = deficit.Add(sdk.NewCoin(coin.Denom, diff.Neg()))
+		}
+	}
+
+	return EscrowAccount{
+		Name:        name,
+		Address:     address.String(),
+		Balance:     balance,
+		Obligations: obligations,
+		Surplus:     surplus,
+		Deficit:     deficit,
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestNewEscrowAccount(t *testing.T) {
+	balance := sdk.NewCoins(sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("iris", 100))
+	obligations := []Obligation{
+		NewObligation(ObligationServiceDeposits, sdk.NewCoins(sdk.NewInt64Coin("iris", 60))),
+		NewObligation(ObligationOutstandingFees, sdk.NewCoins(sdk.NewInt64Coin("btc", 10), sdk.NewInt64Coin("eth", 5))),
+		NewObligation(ObligationUnclaimedFees, sdk.NewCoins(sdk.NewInt64Coin("iris", 20))),
+	}
+
+	account := NewEscrowAccount("service", authtypes.NewModuleAddress("service"), balance, obligations)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("iris", 20)), account.Surplus)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("eth", 5)), account.Deficit)
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
+	servicetypes "github.com/irisnet/irismod/modules/service/types"
+)
+
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected bank keeper (noalias)
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// DistrKeeper defines the expected distribution keeper (noalias)
+type DistrKeeper interface {
+	IterateValidatorOutstandingRewards(ctx sdk.Context, handler func(val sdk.ValAddress, rewards distrtypes.ValidatorOutstandingRewards) (stop bool))
+	GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins
+}
+
+// GovKeeper defines the expected gov keeper (noalias)
+type GovKeeper interface {
+	IterateAllDeposits(ctx sdk.Context, cb func(deposit govtypes.Deposit) (stop bool))
+}
+
+// ServiceKeeper defines the expected service keeper (noalias)
+type ServiceKeeper interface {
+	IterateServiceBindings(ctx sdk.Context, op func(binding servicetypes.ServiceBinding) (stop bool))
+	GetOwnerEarnedFees(ctx sdk.Context, owner sdk.AccAddress) (fees sdk.Coins, found bool)
+	IterateRequestContexts(ctx sdk.Context, op func(requestContextID tmbytes.HexBytes, requestContext servicetypes.RequestContext) (stop bool))
+	IterateActiveRequests(ctx sdk.Context, requestContextID tmbytes.HexBytes, batchCounter uint64, op func(requestID tmbytes.HexBytes, request servicetypes.Request))
+}
+
+// HTLCKeeper defines the expected htlc keeper (noalias)
+type HTLCKeeper interface {
+	IterateHTLCs(ctx sdk.Context, op func(hlock tmbytes.HexBytes, h htlctypes.HTLC) (stop bool))
+}
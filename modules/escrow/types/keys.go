@@ -0,0 +1,14 @@
+package types
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "escrow"
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the escrow querier
+	QueryAccounts = "accounts"
+	QueryAccount  = "account"
+)
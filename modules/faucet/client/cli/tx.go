@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/faucet/types"
+)
+
+// NewTxCmd returns the transaction commands for the faucet module.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "faucet transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdRequestFaucet(),
+		GetCmdFundFaucet(),
+	)
+	return txCmd
+}
+
+// GetCmdRequestFaucet implements the request faucet command.
+func GetCmdRequestFaucet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request [recipient]",
+		Short: "Request coins from the testnet faucet",
+		Long:  "Request coins from the testnet faucet, sent to the given recipient or to the sender if omitted.",
+		Example: fmt.Sprintf(
+			"%s tx faucet request <recipient> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+
+			recipient := fromAddr
+			if len(args) > 0 {
+				if recipient, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRequestFaucet(recipient, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdFundFaucet implements the fund faucet command.
+func GetCmdFundFaucet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund [amount]",
+		Short: "Fund the testnet faucet",
+		Long:  "Fund the testnet faucet with the given amount, only possible on the chains where the faucet is enabled.",
+		Example: fmt.Sprintf(
+			"%s tx faucet fund 1000iris --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundFaucet(amount, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
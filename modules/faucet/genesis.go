@@ -0,0 +1,43 @@
+package faucet
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/faucet/keeper"
+	"github.com/irisnet/irishub/modules/faucet/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize faucet genesis state: %s", err.Error()))
+	}
+
+	// create the faucet module account so that it can be funded
+	k.GetFaucetAccount(ctx)
+
+	k.SetParamSet(ctx, data.Params)
+	k.SetDailyUsage(ctx, data.DailyUsage)
+	for _, request := range data.Requests {
+		k.SetRequest(ctx, request)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var requests []types.Request
+	k.IterateRequests(ctx, func(request types.Request) bool {
+		requests = append(requests, request)
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParamSet(ctx), k.GetStoredDailyUsage(ctx), requests)
+}
+
+// ValidateGenesis performs basic validation of faucet genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
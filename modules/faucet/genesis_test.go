@@ -0,0 +1,52 @@
+package faucet_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/faucet"
+	"github.com/irisnet/irishub/modules/faucet/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) TestInitGenesis() {
+	requestTime := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	genesis := types.NewGenesisState(
+		types.NewParams([]string{"irishub-testnet"}, amount, time.Hour, amount.Add(amount...)),
+		types.NewDailyUsage(types.GetDay(requestTime), amount),
+		[]types.Request{
+			types.NewRequest(sdk.AccAddress(tmhash.SumTruncated([]byte("recipient"))), requestTime),
+		},
+	)
+	faucet.InitGenesis(suite.ctx, suite.app.FaucetKeeper, *genesis)
+
+	exportedGenesis := faucet.ExportGenesis(suite.ctx, suite.app.FaucetKeeper)
+	suite.Equal(genesis, exportedGenesis)
+}
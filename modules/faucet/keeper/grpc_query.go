@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/faucet/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the faucet parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Status queries whether the faucet is enabled, its balance and the coins sent today
+func (k Keeper) Status(c context.Context, _ *types.QueryStatusRequest) (*types.QueryStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryStatusResponse{
+		Enabled:    k.IsEnabled(ctx),
+		Balance:    k.GetBalance(ctx),
+		DailyUsage: k.GetDailyUsage(ctx),
+	}, nil
+}
+
+// Request queries the last faucet request of an address
+func (k Keeper) Request(c context.Context, req *types.QueryRequestRequest) (*types.QueryRequestResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	request, found := k.GetRequest(ctx, address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownRequest, req.Address)
+	}
+
+	return &types.QueryRequestResponse{Request: request}, nil
+}
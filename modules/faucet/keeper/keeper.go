@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/faucet/types"
+)
+
+// Keeper of the faucet store
+type Keeper struct {
+	cdc           codec.Marshaler
+	storeKey      sdk.StoreKey
+	paramSpace    paramtypes.Subspace
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper returns a faucet keeper
+func NewKeeper(
+	cdc codec.Marshaler,
+	key sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+) Keeper {
+	// ensure faucet module account is set
+	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		paramSpace:    paramSpace.WithKeyTable(types.ParamKeyTable()),
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GetParamSet returns faucet params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var p types.Params
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParamSet sets faucet params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetFaucetAccount returns the faucet module account, creating it if needed
+func (k Keeper) GetFaucetAccount(ctx sdk.Context) authtypes.ModuleAccountI {
+	return k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// GetBalance returns the coins held by the faucet module account
+func (k Keeper) GetBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// IsEnabled returns true if the faucet is enabled on the current chain
+func (k Keeper) IsEnabled(ctx sdk.Context) bool {
+	return k.GetParamSet(ctx).IsEnabled(ctx.ChainID())
+}
+
+// RequestFaucet sends the faucet amount to the recipient. Both the recipient
+// and the sender are rate limited, and the coins sent per day are capped.
+func (k Keeper) RequestFaucet(ctx sdk.Context, recipient, sender sdk.AccAddress) (sdk.Coins, error) {
+	params := k.GetParamSet(ctx)
+	if !params.IsEnabled(ctx.ChainID()) {
+		return nil, sdkerrors.Wrapf(types.ErrFaucetDisabled, "chain %s", ctx.ChainID())
+	}
+
+	now := ctx.BlockTime()
+
+	addresses := []sdk.AccAddress{recipient}
+	if !sender.Equals(recipient) {
+		addresses = append(addresses, sender)
+	}
+
+	for _, addr := range addresses {
+		request, found := k.GetRequest(ctx, addr)
+		if !found {
+			continue
+		}
+		if next := request.LastRequestTime.Add(params.RequestInterval); now.Before(next) {
+			return nil, sdkerrors.Wrapf(types.ErrRateLimited, "%s can request again after %s", addr, next.UTC().Format(time.RFC3339))
+		}
+	}
+
+	usage := k.GetDailyUsage(ctx)
+	dispensed := usage.Dispensed.Add(params.Amount...)
+	if !dispensed.IsAllLTE(params.DailyCap) {
+		return nil, sdkerrors.Wrapf(types.ErrDailyCapExceeded, "sent %s of %s today", usage.Dispensed, params.DailyCap)
+	}
+
+	if balance := k.GetBalance(ctx); !params.Amount.IsAllLTE(balance) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientFunds, "balance %s, required %s", balance, params.Amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, params.Amount); err != nil {
+		return nil, err
+	}
+
+	for _, addr := range addresses {
+		k.SetRequest(ctx, types.NewRequest(addr, now))
+	}
+	k.SetDailyUsage(ctx, types.NewDailyUsage(usage.Day, dispensed))
+
+	return params.Amount, nil
+}
+
+// FundFaucet sends coins of the depositor to the faucet. The faucet module
+// account can't receive coins otherwise, so that it is only ever funded on the
+// chains where it pays out.
+func (k Keeper) FundFaucet(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) error {
+	if !k.IsEnabled(ctx) {
+		return sdkerrors.Wrapf(types.ErrFaucetDisabled, "chain %s", ctx.ChainID())
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount)
+}
+
+// GetRequest returns the last faucet request of the given address
+func (k Keeper) GetRequest(ctx sdk.Context, address sdk.AccAddress) (request types.Request, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetRequestKey(address))
+	if bz == nil {
+		return request, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &request)
+	return request, true
+}
+
+// SetRequest stores the last faucet request of an address
+func (k Keeper) SetRequest(ctx sdk.Context, request types.Request) {
+	address, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&request)
+	store.Set(types.GetRequestKey(address), bz)
+}
+
+// IterateRequests iterates through all the faucet requests
+func (k Keeper) IterateRequests(ctx sdk.Context, op func(request types.Request) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.RequestKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.Request
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &request)
+
+		if stop := op(request); stop {
+			break
+		}
+	}
+}
+
+// GetDailyUsage returns the coins sent on the day of the current block
+func (k Keeper) GetDailyUsage(ctx sdk.Context) types.DailyUsage {
+	today := types.GetDay(ctx.BlockTime())
+
+	usage := k.GetStoredDailyUsage(ctx)
+	if usage.Day != today {
+		return types.NewDailyUsage(today, sdk.NewCoins())
+	}
+	return usage
+}
+
+// GetStoredDailyUsage returns the daily usage as stored, which may belong to
+// a past day
+func (k Keeper) GetStoredDailyUsage(ctx sdk.Context) (usage types.DailyUsage) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.DailyUsageKey)
+	if bz == nil {
+		return types.NewDailyUsage(0, sdk.NewCoins())
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &usage)
+	return usage
+}
+
+// SetDailyUsage stores the coins sent on a day
+func (k Keeper) SetDailyUsage(ctx sdk.Context, usage types.DailyUsage) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&usage)
+	store.Set(types.DailyUsageKey, bz)
+}
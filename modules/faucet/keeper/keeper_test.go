@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+
+	"github.com/irisnet/irishub/modules/faucet/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	testChainID = "irishub-testnet"
+	testTime    = time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testAmount   = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	testDailyCap = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+
+	recipient  = sdk.AccAddress(tmhash.SumTruncated([]byte("recipient")))
+	recipient2 = sdk.AccAddress(tmhash.SumTruncated([]byte("recipient2")))
+	recipient3 = sdk.AccAddress(tmhash.SumTruncated([]byte("recipient3")))
+	sender     = sdk.AccAddress(tmhash.SumTruncated([]byte("sender")))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{ChainID: testChainID, Time: testTime})
+	suite.app = app
+
+	params := types.NewParams([]string{testChainID}, testAmount, time.Hour, testDailyCap)
+	suite.app.FaucetKeeper.SetParamSet(suite.ctx, params)
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	suite.NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, funds))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, minttypes.ModuleName, types.ModuleName, funds))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestSetGetParamSet() {
+	params := types.DefaultParams()
+	suite.app.FaucetKeeper.SetParamSet(suite.ctx, params)
+	suite.Equal(params, suite.app.FaucetKeeper.GetParamSet(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestRequestFaucet() {
+	amount, err := suite.app.FaucetKeeper.RequestFaucet(suite.ctx, recipient, sender)
+	suite.NoError(err)
+	suite.Equal(testAmount, amount)
+	suite.Equal(testAmount, suite.app.BankKeeper.GetAllBalances(suite.ctx, recipient))
+
+	for _, addr := range []sdk.AccAddress{recipient, sender} {
+		request, found := suite.app.FaucetKeeper.GetRequest(suite.ctx, addr)
+		suite.True(found)
+		suite.Equal(types.NewRequest(addr, testTime), request)
+	}
+
+	usage := suite.app.FaucetKeeper.GetDailyUsage(suite.ctx)
+	suite.Equal(types.NewDailyUsage(types.GetDay(testTime), testAmount), usage)
+}
+
+func (suite *KeeperTestSuite) TestRequestFaucetDisabled() {
+	ctx := suite.ctx.WithChainID("irishub")
+
+	_, err := suite.app.FaucetKeeper.RequestFaucet(ctx, recipient, sender)
+	suite.ErrorIs(err, types.ErrFaucetDisabled)
+}
+
+func (suite *KeeperTestSuite) TestRequestFaucetRateLimited() {
+	_, err := suite.app.FaucetKeeper.RequestFaucet(suite.ctx, recipient, recipient)
+	suite.NoError(err)
+
+	ctx := suite.ctx.WithBlockTime(testTime.Add(30 * time.Minute))
+	_, err = suite.app.FaucetKeeper.RequestFaucet(ctx, recipient, recipient)
+	suite.ErrorIs(err, types.ErrRateLimited)
+
+	// the sender is rate limited as well
+	_, err = suite.app.FaucetKeeper.RequestFaucet(ctx, recipient2, recipient)
+	suite.ErrorIs(err, types.ErrRateLimited)
+
+	ctx = suite.ctx.WithBlockTime(testTime.Add(time.Hour))
+	_, err = suite.app.FaucetKeeper.RequestFaucet(ctx, recipient, recipient)
+	suite.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRequestFaucetDailyCap() {
+	_, err := suite.app.FaucetKeeper.RequestFaucet(suite.ctx, recipient, recipient)
+	suite.NoError(err)
+	_, err = suite.app.FaucetKeeper.RequestFaucet(suite.ctx, recipient2, recipient2)
+	suite.NoError(err)
+
+	_, err = suite.app.FaucetKeeper.RequestFaucet(suite.ctx, recipient3, recipient3)
+	suite.ErrorIs(err, types.ErrDailyCapExceeded)
+
+	// the cap is reset on the next day
+	ctx := suite.ctx.WithBlockTime(testTime.Add(24 * time.Hour))
+	_, err = suite.app.FaucetKeeper.RequestFaucet(ctx, recipient3, recipient3)
+	suite.NoError(err)
+	suite.Equal(testAmount, suite.app.FaucetKeeper.GetDailyUsage(ctx).Dispensed)
+}
+
+func (suite *KeeperTestSuite) TestRequestFaucetInsufficientFunds() {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1100))
+	params := types.NewParams([]string{testChainID}, amount, time.Hour, amount)
+	suite.app.FaucetKeeper.SetParamSet(suite.ctx, params)
+
+	_, err := suite.app.FaucetKeeper.RequestFaucet(suite.ctx, recipient, sender)
+	suite.ErrorIs(err, types.ErrInsufficientFunds)
+}
+
+func (suite *KeeperTestSuite) TestFundFaucet() {
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	suite.NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, funds))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sender, funds))
+
+	balance := suite.app.FaucetKeeper.GetBalance(suite.ctx)
+	suite.NoError(suite.app.FaucetKeeper.FundFaucet(suite.ctx, sender, funds))
+	suite.Equal(balance.Add(funds...), suite.app.FaucetKeeper.GetBalance(suite.ctx))
+	suite.True(suite.app.BankKeeper.GetAllBalances(suite.ctx, sender).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestFundFaucetDisabled() {
+	ctx := suite.ctx.WithChainID("irishub")
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	suite.NoError(suite.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, funds))
+
+	err := suite.app.FaucetKeeper.FundFaucet(ctx, sender, funds)
+	suite.ErrorIs(err, types.ErrFaucetDisabled)
+}
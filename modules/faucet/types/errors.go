@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// faucet module sentinel errors
+var (
+	ErrFaucetDisabled    = sdkerrors.Register(ModuleName, 2, "faucet disabled")
+	ErrRateLimited       = sdkerrors.Register(ModuleName, 3, "faucet requested too often")
+	ErrDailyCapExceeded  = sdkerrors.Register(ModuleName, 4, "faucet daily cap exceeded")
+	ErrInsufficientFunds = sdkerrors.Register(ModuleName, 5, "insufficient faucet funds")
+	ErrUnknownRequest    = sdkerrors.Register(ModuleName, 6, "unknown faucet request")
+)
@@ -0,0 +1,14 @@
+// nolint
+package types
+
+// faucet module event types
+const (
+	EventTypeRequestFaucet = "request_faucet"
+	EventTypeFundFaucet    = "fund_faucet"
+
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyDepositor = "depositor"
+	AttributeKeyAmount    = "amount"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// secondsPerDay is the length of the period the daily cap applies to
+const secondsPerDay = 24 * 60 * 60
+
+// GetDay returns the number of days since the unix epoch at the given time
+func GetDay(t time.Time) int64 {
+	return t.Unix() / secondsPerDay
+}
+
+// NewDailyUsage constructs a DailyUsage
+func NewDailyUsage(day int64, dispensed sdk.Coins) DailyUsage {
+	return DailyUsage{
+		Day:       day,
+		Dispensed: dispensed,
+	}
+}
+
+// NewRequest constructs a Request
+func NewRequest(address sdk.AccAddress, lastRequestTime time.Time) Request {
+	return Request{
+		Address:         address.String(),
+		LastRequestTime: lastRequestTime,
+	}
+}
@@ -0,0 +1,967 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: faucet/faucet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines faucet module's parameters
+type Params struct {
+	// chains on which the faucet is enabled, the faucet is disabled on all others
+	EnabledChainIds []string `protobuf:"bytes,1,rep,name=enabled_chain_ids,json=enabledChainIds,proto3" json:"enabled_chain_ids,omitempty" yaml:"enabled_chain_ids"`
+	// coins sent for each request
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// minimum time between two requests of the same address
+	RequestInterval time.Duration `protobuf:"bytes,3,opt,name=request_interval,json=requestInterval,proto3,stdduration" json:"request_interval" yaml:"request_interval"`
+	// maximum coins sent per day
+	DailyCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=daily_cap,json=dailyCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_cap" yaml:"daily_cap"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb075c8fce600193, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabledChainIds() []string {
+	if m != nil {
+		return m.EnabledChainIds
+	}
+	return nil
+}
+
+func (m *Params) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Params) GetRequestInterval() time.Duration {
+	if m != nil {
+		return m.RequestInterval
+	}
+	return 0
+}
+
+func (m *Params) GetDailyCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailyCap
+	}
+	return nil
+}
+
+// DailyUsage defines the coins sent by the faucet on a day
+type DailyUsage struct {
+	// days since the unix epoch
+	Day       int64                                    `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Dispensed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=dispensed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dispensed"`
+}
+
+func (m *DailyUsage) Reset()         { *m = DailyUsage{} }
+func (m *DailyUsage) String() string { return proto.CompactTextString(m) }
+func (*DailyUsage) ProtoMessage()    {}
+func (*DailyUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb075c8fce600193, []int{1}
+}
+func (m *DailyUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DailyUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DailyUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DailyUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyUsage.Merge(m, src)
+}
+func (m *DailyUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DailyUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailyUsage proto.InternalMessageInfo
+
+func (m *DailyUsage) GetDay() int64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *DailyUsage) GetDispensed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Dispensed
+	}
+	return nil
+}
+
+// Request defines the time an address last requested the faucet
+type Request struct {
+	Address         string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LastRequestTime time.Time `protobuf:"bytes,2,opt,name=last_request_time,json=lastRequestTime,proto3,stdtime" json:"last_request_time" yaml:"last_request_time"`
+}
+
+func (m *Request) Reset()         { *m = Request{} }
+func (m *Request) String() string { return proto.CompactTextString(m) }
+func (*Request) ProtoMessage()    {}
+func (*Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb075c8fce600193, []int{2}
+}
+func (m *Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Request.Merge(m, src)
+}
+func (m *Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Request proto.InternalMessageInfo
+
+func (m *Request) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Request) GetLastRequestTime() time.Time {
+	if m != nil {
+		return m.LastRequestTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "irishub.faucet.Params")
+	proto.RegisterType((*DailyUsage)(nil), "irishub.faucet.DailyUsage")
+	proto.RegisterType((*Request)(nil), "irishub.faucet.Request")
+}
+
+func init() { proto.RegisterFile("faucet/faucet.proto", fileDescriptor_fb075c8fce600193) }
+
+var fileDescriptor_fb075c8fce600193 = []byte{
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xf7, 0xd5, 0x55, 0x4a, 0x0e, 0x89, 0xa6, 0x06, 0x09, 0x13, 0x21, 0x3b, 0x32, 0x0c, 0x59,
+	0xf0, 0x51, 0xd8, 0x3a, 0x3a, 0x19, 0xc8, 0x86, 0x2c, 0x58, 0x58, 0xac, 0xb3, 0xef, 0xea, 0x9e,
+	0xb0, 0x7d, 0xc6, 0x77, 0xae, 0x14, 0x89, 0x2f, 0xc0, 0x56, 0xb6, 0x8e, 0x4c, 0x0c, 0x7c, 0x92,
+	0x8e, 0x1d, 0x99, 0x52, 0x94, 0x7c, 0x83, 0x7e, 0x02, 0x74, 0xbe, 0x33, 0xa0, 0x64, 0x40, 0x0c,
+	0x4c, 0x7e, 0xf7, 0xf3, 0xfb, 0xf7, 0xfb, 0xbd, 0xf7, 0xe0, 0xfd, 0x53, 0xdc, 0x66, 0x54, 0x22,
+	0xfd, 0x09, 0xeb, 0x86, 0x4b, 0xee, 0xdc, 0x63, 0x0d, 0x13, 0x67, 0x6d, 0x1a, 0x6a, 0x74, 0xec,
+	0x65, 0x5c, 0x94, 0x5c, 0xa0, 0x14, 0x0b, 0x8a, 0xce, 0x8f, 0x53, 0x2a, 0xf1, 0x31, 0xca, 0x38,
+	0xab, 0xb4, 0xff, 0xf8, 0x41, 0xce, 0x73, 0xde, 0x99, 0x48, 0x59, 0x06, 0xf5, 0x72, 0xce, 0xf3,
+	0x82, 0xa2, 0xee, 0x95, 0xb6, 0xa7, 0x88, 0xb4, 0x0d, 0x96, 0x8c, 0xf7, 0x51, 0xfe, 0xf6, 0x7f,
+	0xc9, 0x4a, 0x2a, 0x24, 0x2e, 0x6b, 0xed, 0x10, 0x7c, 0xb5, 0xe1, 0xe0, 0x35, 0x6e, 0x70, 0x29,
+	0x9c, 0x57, 0xf0, 0x88, 0x56, 0x38, 0x2d, 0x28, 0x49, 0xb2, 0x33, 0xcc, 0xaa, 0x84, 0x11, 0xe1,
+	0x82, 0x89, 0x3d, 0x1d, 0x46, 0x8f, 0x6f, 0x57, 0xbe, 0xbb, 0xc4, 0x65, 0x71, 0x12, 0xec, 0xb8,
+	0x04, 0xf1, 0xa1, 0xc1, 0x66, 0x0a, 0x5a, 0x10, 0xe1, 0x64, 0x70, 0x80, 0x4b, 0xde, 0x56, 0xd2,
+	0xdd, 0x9b, 0xd8, 0xd3, 0xbb, 0x2f, 0x1e, 0x85, 0x9a, 0x5c, 0xa8, 0xc8, 0x85, 0x86, 0x5c, 0x38,
+	0xe3, 0xac, 0x8a, 0x9e, 0x5f, 0xad, 0x7c, 0xeb, 0xdb, 0x8d, 0x3f, 0xcd, 0x99, 0x54, 0x6a, 0x64,
+	0xbc, 0x44, 0x46, 0x09, 0xfd, 0x79, 0x26, 0xc8, 0x7b, 0x24, 0x97, 0x35, 0x15, 0x5d, 0x80, 0x88,
+	0x4d, 0x6a, 0x87, 0xc1, 0x51, 0x43, 0x3f, 0xb4, 0x54, 0xc8, 0x84, 0x55, 0x92, 0x36, 0xe7, 0xb8,
+	0x70, 0xed, 0x09, 0xe8, 0xca, 0x69, 0xd6, 0x61, 0xcf, 0x3a, 0x9c, 0x1b, 0x55, 0xa2, 0x27, 0xaa,
+	0xdc, 0xed, 0xca, 0x7f, 0xa8, 0xc9, 0x6c, 0x27, 0x08, 0x2e, 0x6f, 0x7c, 0x10, 0x1f, 0x1a, 0x78,
+	0x61, 0x50, 0xe7, 0x23, 0x1c, 0x12, 0xcc, 0x8a, 0x65, 0x92, 0xe1, 0xda, 0xdd, 0xff, 0x1b, 0xa5,
+	0xb9, 0xa9, 0x31, 0xd2, 0x35, 0x7e, 0x45, 0x06, 0xff, 0x44, 0xf3, 0x4e, 0x17, 0x37, 0xc3, 0xf5,
+	0xc9, 0xfe, 0xe5, 0x17, 0xdf, 0x0a, 0x3e, 0x01, 0x08, 0xe7, 0x0a, 0x7a, 0x2b, 0x70, 0x4e, 0x9d,
+	0x11, 0xb4, 0x09, 0x5e, 0xba, 0x60, 0x02, 0xa6, 0x76, 0xac, 0x4c, 0x87, 0xc1, 0x21, 0x61, 0xa2,
+	0xa6, 0x95, 0xa0, 0xe4, 0x7f, 0xe8, 0xfe, 0x3b, 0x7b, 0xf0, 0x19, 0xc0, 0x83, 0x58, 0x6b, 0xe4,
+	0xb8, 0xf0, 0x00, 0x13, 0xd2, 0x50, 0x21, 0xba, 0x66, 0x86, 0x71, 0xff, 0x74, 0x0a, 0x78, 0x54,
+	0x60, 0x21, 0x93, 0x5e, 0x64, 0xb5, 0x7a, 0xee, 0x5e, 0x37, 0xa1, 0xf1, 0xce, 0x84, 0xde, 0xf4,
+	0x7b, 0x19, 0x3d, 0x35, 0xf2, 0x99, 0x7d, 0xdb, 0x49, 0x11, 0x5c, 0x74, 0x33, 0x52, 0xb8, 0xe9,
+	0x41, 0xc5, 0x46, 0x8b, 0xab, 0xb5, 0x07, 0xae, 0xd7, 0x1e, 0xf8, 0xb1, 0xf6, 0xc0, 0xc5, 0xc6,
+	0xb3, 0xae, 0x37, 0x9e, 0xf5, 0x7d, 0xe3, 0x59, 0xef, 0xd0, 0x1f, 0x14, 0xd5, 0xd1, 0x55, 0x54,
+	0x22, 0x73, 0x7c, 0xa8, 0xe4, 0xa4, 0x2d, 0xa8, 0x30, 0xa7, 0xa9, 0xf9, 0xa6, 0x83, 0xae, 0xab,
+	0x97, 0x3f, 0x07, 0x00, 0xc4, 0xfc, 0xda, 0xac, 0xb8, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DailyCap) > 0 {
+		for iNdEx := len(m.DailyCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFaucet(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RequestInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RequestInterval):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFaucet(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFaucet(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EnabledChainIds) > 0 {
+		for iNdEx := len(m.EnabledChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnabledChainIds[iNdEx])
+			copy(dAtA[i:], m.EnabledChainIds[iNdEx])
+			i = encodeVarintFaucet(dAtA, i, uint64(len(m.EnabledChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DailyUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DailyUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DailyUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dispensed) > 0 {
+		for iNdEx := len(m.Dispensed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dispensed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFaucet(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Day != 0 {
+		i = encodeVarintFaucet(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRequestTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRequestTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFaucet(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFaucet(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFaucet(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFaucet(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EnabledChainIds) > 0 {
+		for _, s := range m.EnabledChainIds {
+			l = len(s)
+			n += 1 + l + sovFaucet(uint64(l))
+		}
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovFaucet(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RequestInterval)
+	n += 1 + l + sovFaucet(uint64(l))
+	if len(m.DailyCap) > 0 {
+		for _, e := range m.DailyCap {
+			l = e.Size()
+			n += 1 + l + sovFaucet(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DailyUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Day != 0 {
+		n += 1 + sovFaucet(uint64(m.Day))
+	}
+	if len(m.Dispensed) > 0 {
+		for _, e := range m.Dispensed {
+			l = e.Size()
+			n += 1 + l + sovFaucet(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFaucet(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRequestTime)
+	n += 1 + l + sovFaucet(uint64(l))
+	return n
+}
+
+func sovFaucet(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFaucet(x uint64) (n int) {
+	return sovFaucet(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFaucet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnabledChainIds = append(m.EnabledChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RequestInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyCap = append(m.DailyCap, types.Coin{})
+			if err := m.DailyCap[len(m.DailyCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFaucet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DailyUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFaucet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DailyUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DailyUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispensed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dispensed = append(m.Dispensed, types.Coin{})
+			if err := m.Dispensed[len(m.Dispensed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFaucet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFaucet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRequestTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRequestTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFaucet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFaucet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFaucet(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFaucet
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFaucet
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFaucet
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFaucet
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFaucet
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFaucet        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFaucet          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFaucet = fmt.Errorf("proto: unexpected end of group")
+)
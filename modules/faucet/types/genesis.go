@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(params Params, dailyUsage DailyUsage, requests []Request) *GenesisState {
+	return &GenesisState{
+		Params:     params,
+		DailyUsage: dailyUsage,
+		Requests:   requests,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided faucet genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if !data.DailyUsage.Dispensed.IsValid() {
+		return fmt.Errorf("invalid faucet daily usage [%s]", data.DailyUsage.Dispensed)
+	}
+
+	addresses := make(map[string]bool, len(data.Requests))
+	for _, request := range data.Requests {
+		if _, err := sdk.AccAddressFromBech32(request.Address); err != nil {
+			return err
+		}
+		if addresses[request.Address] {
+			return fmt.Errorf("duplicate faucet request of [%s]", request.Address)
+		}
+		addresses[request.Address] = true
+	}
+
+	return nil
+}
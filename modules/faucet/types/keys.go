@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "faucet"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the faucet querier
+	QueryParameters = "parameters"
+	QueryStatus     = "status"
+	QueryRequest    = "request"
+)
+
+var (
+	// Keys for store prefixes
+	RequestKey    = []byte{0x01} // prefix for the last request time of each address
+	DailyUsageKey = []byte{0x02} // key for the coins sent on the current day
+)
+
+// GetRequestKey returns the key of the last request of the given address
+func GetRequestKey(address sdk.AccAddress) []byte {
+	return append(RequestKey, address.Bytes()...)
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgRequestFaucet = "request_faucet" // type for MsgRequestFaucet
+	TypeMsgFundFaucet    = "fund_faucet"    // type for MsgFundFaucet
+)
+
+var (
+	_ sdk.Msg = &MsgRequestFaucet{}
+	_ sdk.Msg = &MsgFundFaucet{}
+)
+
+// NewMsgRequestFaucet constructs a MsgRequestFaucet
+func NewMsgRequestFaucet(recipient, sender sdk.AccAddress) *MsgRequestFaucet {
+	return &MsgRequestFaucet{
+		Recipient: recipient.String(),
+		Sender:    sender.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRequestFaucet) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRequestFaucet) Type() string { return TypeMsgRequestFaucet }
+
+// GetSignBytes implements Msg.
+func (msg MsgRequestFaucet) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgRequestFaucet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgRequestFaucet) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgFundFaucet constructs a MsgFundFaucet
+func NewMsgFundFaucet(amount sdk.Coins, depositor sdk.AccAddress) *MsgFundFaucet {
+	return &MsgFundFaucet{
+		Amount:    amount,
+		Depositor: depositor.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgFundFaucet) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgFundFaucet) Type() string { return TypeMsgFundFaucet }
+
+// GetSignBytes implements Msg.
+func (msg MsgFundFaucet) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgFundFaucet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid funding amount (%s)", msg.Amount)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgFundFaucet) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store key
+var (
+	KeyEnabledChainIDs = []byte("EnabledChainIDs")
+	KeyAmount          = []byte("Amount")
+	KeyRequestInterval = []byte("RequestInterval")
+	KeyDailyCap        = []byte("DailyCap")
+)
+
+// ParamKeyTable for faucet module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs Params
+func NewParams(enabledChainIDs []string, amount sdk.Coins, requestInterval time.Duration, dailyCap sdk.Coins) Params {
+	return Params{
+		EnabledChainIds: enabledChainIDs,
+		Amount:          amount,
+		RequestInterval: requestInterval,
+		DailyCap:        dailyCap,
+	}
+}
+
+// DefaultParams returns default faucet module parameters.
+// The faucet is disabled on every chain until a chain ID is allowed.
+func DefaultParams() Params {
+	return NewParams(
+		nil,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000_000))),
+		24*time.Hour,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100_000_000_000))),
+	)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabledChainIDs, &p.EnabledChainIds, validateEnabledChainIDs),
+		paramtypes.NewParamSetPair(KeyAmount, &p.Amount, validateAmount),
+		paramtypes.NewParamSetPair(KeyRequestInterval, &p.RequestInterval, validateRequestInterval),
+		paramtypes.NewParamSetPair(KeyDailyCap, &p.DailyCap, validateDailyCap),
+	}
+}
+
+// GetParamSpace implements params.ParamStruct
+func (p *Params) GetParamSpace() string {
+	return DefaultParamSpace
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateEnabledChainIDs(p.EnabledChainIds); err != nil {
+		return err
+	}
+	if err := validateAmount(p.Amount); err != nil {
+		return err
+	}
+	if err := validateRequestInterval(p.RequestInterval); err != nil {
+		return err
+	}
+	if err := validateDailyCap(p.DailyCap); err != nil {
+		return err
+	}
+	if !p.Amount.IsAllLTE(p.DailyCap) {
+		return fmt.Errorf("faucet amount [%s] must not exceed the daily cap [%s]", p.Amount, p.DailyCap)
+	}
+	return nil
+}
+
+// IsEnabled returns true if the faucet is enabled on the given chain
+func (p Params) IsEnabled(chainID string) bool {
+	for _, id := range p.EnabledChainIds {
+		if id == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+func validateEnabledChainIDs(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	ids := make(map[string]bool, len(v))
+	for _, id := range v {
+		if len(id) == 0 {
+			return fmt.Errorf("enabled chain id cannot be blank")
+		}
+		if ids[id] {
+			return fmt.Errorf("duplicate enabled chain id [%s]", id)
+		}
+		ids[id] = true
+	}
+	return nil
+}
+
+func validateAmount(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid faucet amount [%s]", v)
+	}
+	return nil
+}
+
+func validateRequestInterval(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("faucet request interval must be positive, got %s", v)
+	}
+	return nil
+}
+
+func validateDailyCap(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsValid() {
+		return fmt.Errorf("invalid faucet daily cap [%s]", v)
+	}
+	return nil
+}
@@ -0,0 +1,980 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: faucet/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRequestFaucet defines the properties of a faucet request message
+type MsgRequestFaucet struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Sender    string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgRequestFaucet) Reset()         { *m = MsgRequestFaucet{} }
+func (m *MsgRequestFaucet) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestFaucet) ProtoMessage()    {}
+func (*MsgRequestFaucet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98296dcd439b0d1f, []int{0}
+}
+func (m *MsgRequestFaucet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestFaucet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestFaucet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestFaucet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestFaucet.Merge(m, src)
+}
+func (m *MsgRequestFaucet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestFaucet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestFaucet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestFaucet proto.InternalMessageInfo
+
+func (m *MsgRequestFaucet) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgRequestFaucet) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgRequestFaucetResponse defines the Msg/RequestFaucet response type
+type MsgRequestFaucetResponse struct {
+}
+
+func (m *MsgRequestFaucetResponse) Reset()         { *m = MsgRequestFaucetResponse{} }
+func (m *MsgRequestFaucetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestFaucetResponse) ProtoMessage()    {}
+func (*MsgRequestFaucetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98296dcd439b0d1f, []int{1}
+}
+func (m *MsgRequestFaucetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestFaucetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestFaucetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestFaucetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestFaucetResponse.Merge(m, src)
+}
+func (m *MsgRequestFaucetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestFaucetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestFaucetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestFaucetResponse proto.InternalMessageInfo
+
+// MsgFundFaucet defines the properties of a faucet funding message
+type MsgFundFaucet struct {
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Depositor string                                   `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *MsgFundFaucet) Reset()         { *m = MsgFundFaucet{} }
+func (m *MsgFundFaucet) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFaucet) ProtoMessage()    {}
+func (*MsgFundFaucet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98296dcd439b0d1f, []int{2}
+}
+func (m *MsgFundFaucet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFaucet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFaucet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundFaucet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFaucet.Merge(m, src)
+}
+func (m *MsgFundFaucet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFaucet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFaucet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundFaucet proto.InternalMessageInfo
+
+func (m *MsgFundFaucet) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgFundFaucet) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+// MsgFundFaucetResponse defines the Msg/FundFaucet response type
+type MsgFundFaucetResponse struct {
+}
+
+func (m *MsgFundFaucetResponse) Reset()         { *m = MsgFundFaucetResponse{} }
+func (m *MsgFundFaucetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFaucetResponse) ProtoMessage()    {}
+func (*MsgFundFaucetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98296dcd439b0d1f, []int{3}
+}
+func (m *MsgFundFaucetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFaucetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFaucetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundFaucetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFaucetResponse.Merge(m, src)
+}
+func (m *MsgFundFaucetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFaucetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFaucetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundFaucetResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRequestFaucet)(nil), "irishub.faucet.MsgRequestFaucet")
+	proto.RegisterType((*MsgRequestFaucetResponse)(nil), "irishub.faucet.MsgRequestFaucetResponse")
+	proto.RegisterType((*MsgFundFaucet)(nil), "irishub.faucet.MsgFundFaucet")
+	proto.RegisterType((*MsgFundFaucetResponse)(nil), "irishub.faucet.MsgFundFaucetResponse")
+}
+
+func init() { proto.RegisterFile("faucet/tx.proto", fileDescriptor_98296dcd439b0d1f) }
+
+var fileDescriptor_98296dcd439b0d1f = []byte{
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0x4d, 0xbe, 0x42, 0xa1, 0xf3, 0x51, 0x95, 0xe0, 0x4f, 0x0d, 0x9a, 0x96, 0x80, 0x90, 0x8d,
+	0x33, 0xb6, 0xbe, 0x41, 0x85, 0xa2, 0x8b, 0x6e, 0xb2, 0xd4, 0x55, 0x7e, 0xae, 0x71, 0xd0, 0xcc,
+	0xc4, 0xdc, 0x89, 0xe8, 0x5b, 0x88, 0x8f, 0xe1, 0x03, 0xf8, 0x0c, 0x5d, 0x76, 0xe9, 0x4a, 0xa5,
+	0x7d, 0x11, 0xc9, 0x1f, 0xfd, 0x41, 0x74, 0x95, 0xcc, 0x3d, 0xf7, 0x9e, 0x7b, 0xce, 0xe1, 0x92,
+	0xcd, 0x6b, 0x2f, 0x0b, 0x40, 0x31, 0xf5, 0x48, 0x93, 0x54, 0x2a, 0x69, 0x6c, 0xf0, 0x94, 0xe3,
+	0x4d, 0xe6, 0xd3, 0x12, 0x30, 0xb7, 0x23, 0x19, 0xc9, 0x02, 0x62, 0xf9, 0x5f, 0xd9, 0x65, 0x5a,
+	0x81, 0xc4, 0x58, 0x22, 0xf3, 0x3d, 0x04, 0xf6, 0xd0, 0xf7, 0x41, 0x79, 0x7d, 0x16, 0x48, 0x2e,
+	0x4a, 0xdc, 0x3e, 0x27, 0x5b, 0x63, 0x8c, 0x5c, 0xb8, 0xcf, 0x00, 0xd5, 0xa8, 0x60, 0x32, 0x0e,
+	0x48, 0x2b, 0x85, 0x80, 0x27, 0x1c, 0x84, 0xea, 0xe8, 0x3d, 0xdd, 0x69, 0xb9, 0x8b, 0x82, 0xb1,
+	0x4b, 0x9a, 0x08, 0x22, 0x84, 0xb4, 0xf3, 0xaf, 0x80, 0xaa, 0x97, 0x6d, 0x92, 0xce, 0x3a, 0x93,
+	0x0b, 0x98, 0x48, 0x81, 0x60, 0xbf, 0xe8, 0xa4, 0x3d, 0xc6, 0x68, 0x94, 0x89, 0xb0, 0xda, 0x11,
+	0x90, 0xa6, 0x17, 0xcb, 0xac, 0x58, 0xd0, 0x70, 0xfe, 0x0f, 0xf6, 0x69, 0x29, 0x94, 0xe6, 0x42,
+	0x69, 0x25, 0x94, 0x9e, 0x49, 0x2e, 0x86, 0x27, 0x93, 0x8f, 0xae, 0xf6, 0xfa, 0xd9, 0x75, 0x22,
+	0xae, 0x72, 0xbf, 0x81, 0x8c, 0x59, 0xe5, 0xaa, 0xfc, 0x1c, 0x63, 0x78, 0xcb, 0xd4, 0x53, 0x02,
+	0x58, 0x0c, 0xa0, 0x5b, 0x51, 0xe7, 0x46, 0x42, 0x48, 0x24, 0x72, 0x25, 0x6b, 0xb5, 0x8b, 0x82,
+	0xbd, 0x47, 0x76, 0x56, 0x34, 0xd5, 0x6a, 0x07, 0x6f, 0x3a, 0x69, 0x8c, 0x31, 0x32, 0xae, 0x48,
+	0x7b, 0x35, 0x98, 0x1e, 0x5d, 0xcd, 0x9c, 0xae, 0x1b, 0x36, 0x9d, 0xbf, 0x3a, 0xea, 0x25, 0x86,
+	0x4b, 0xc8, 0x52, 0x1c, 0x87, 0x3f, 0xcc, 0x2d, 0x60, 0xf3, 0xe8, 0x57, 0xb8, 0xe6, 0x1c, 0x5e,
+	0x4c, 0x66, 0x96, 0x3e, 0x9d, 0x59, 0xfa, 0xd7, 0xcc, 0xd2, 0x9f, 0xe7, 0x96, 0x36, 0x9d, 0x5b,
+	0xda, 0xfb, 0xdc, 0xd2, 0x2e, 0xd9, 0x52, 0x76, 0x39, 0x95, 0x00, 0xc5, 0x2a, 0x4a, 0x16, 0xcb,
+	0x30, 0xbb, 0x03, 0x64, 0xf5, 0x81, 0xe5, 0x41, 0xfa, 0xcd, 0xe2, 0x3c, 0x4e, 0xbf, 0x07, 0x00,
+	0x56, 0x31, 0x48, 0x17, 0x77, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RequestFaucet defines a method for requesting coins from the faucet
+	RequestFaucet(ctx context.Context, in *MsgRequestFaucet, opts ...grpc.CallOption) (*MsgRequestFaucetResponse, error)
+	// FundFaucet defines a method for funding the faucet
+	FundFaucet(ctx context.Context, in *MsgFundFaucet, opts ...grpc.CallOption) (*MsgFundFaucetResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RequestFaucet(ctx context.Context, in *MsgRequestFaucet, opts ...grpc.CallOption) (*MsgRequestFaucetResponse, error) {
+	out := new(MsgRequestFaucetResponse)
+	err := c.cc.Invoke(ctx, "/irishub.faucet.Msg/RequestFaucet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundFaucet(ctx context.Context, in *MsgFundFaucet, opts ...grpc.CallOption) (*MsgFundFaucetResponse, error) {
+	out := new(MsgFundFaucetResponse)
+	err := c.cc.Invoke(ctx, "/irishub.faucet.Msg/FundFaucet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RequestFaucet defines a method for requesting coins from the faucet
+	RequestFaucet(context.Context, *MsgRequestFaucet) (*MsgRequestFaucetResponse, error)
+	// FundFaucet defines a method for funding the faucet
+	FundFaucet(context.Context, *MsgFundFaucet) (*MsgFundFaucetResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RequestFaucet(ctx context.Context, req *MsgRequestFaucet) (*MsgRequestFaucetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestFaucet not implemented")
+}
+func (*UnimplementedMsgServer) FundFaucet(ctx context.Context, req *MsgFundFaucet) (*MsgFundFaucetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundFaucet not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RequestFaucet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestFaucet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestFaucet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.faucet.Msg/RequestFaucet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestFaucet(ctx, req.(*MsgRequestFaucet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundFaucet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundFaucet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundFaucet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.faucet.Msg/FundFaucet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundFaucet(ctx, req.(*MsgFundFaucet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.faucet.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestFaucet",
+			Handler:    _Msg_RequestFaucet_Handler,
+		},
+		{
+			MethodName: "FundFaucet",
+			Handler:    _Msg_FundFaucet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "faucet/tx.proto",
+}
+
+func (m *MsgRequestFaucet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestFaucet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestFaucet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestFaucetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestFaucetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestFaucetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFaucet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFaucet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFaucet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFaucetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFaucetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFaucetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRequestFaucet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestFaucetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundFaucet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundFaucetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRequestFaucet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestFaucet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestFaucet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestFaucetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestFaucetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestFaucetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundFaucet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundFaucet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundFaucet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundFaucetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundFaucetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundFaucetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,49 @@
+package featuregate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/featuregate"
+	"github.com/irisnet/irishub/modules/featuregate/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) TestExportGenesis() {
+	defaultGenesis := types.DefaultGenesisState()
+	exportedGenesis := featuregate.ExportGenesis(suite.ctx, suite.app.FeatureGateKeeper)
+	suite.Equal(defaultGenesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestInitGenesis() {
+	genesis := types.NewGenesisState(
+		types.NewParams([]types.FeatureGate{types.NewFeatureGate("fee-refund", 100)}),
+	)
+	featuregate.InitGenesis(suite.ctx, suite.app.FeatureGateKeeper, *genesis)
+
+	exportedGenesis := featuregate.ExportGenesis(suite.ctx, suite.app.FeatureGateKeeper)
+	suite.Equal(genesis, exportedGenesis)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/featuregate/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the featuregate parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Feature queries the gate of a feature and whether it is active
+func (k Keeper) Feature(c context.Context, req *types.QueryFeatureRequest) (*types.QueryFeatureResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	gate, found := k.GetFeatureGate(ctx, req.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownFeature, req.Name)
+	}
+
+	return &types.QueryFeatureResponse{Gate: gate, Active: gate.IsActive(ctx.BlockHeight())}, nil
+}
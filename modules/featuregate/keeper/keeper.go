@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/featuregate/types"
+)
+
+// Keeper of the featuregate store
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper returns a featuregate keeper
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	return Keeper{
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GetParamSet returns featuregate params from the global param store.
+// The gates may be absent on chains that added the module by an upgrade,
+// in which case no feature is scheduled.
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyGates, &params.Gates)
+	return params
+}
+
+// SetParamSet sets featuregate params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetFeatureGate returns the gate scheduled for the given feature
+func (k Keeper) GetFeatureGate(ctx sdk.Context, name string) (types.FeatureGate, bool) {
+	return k.GetParamSet(ctx).GetGate(name)
+}
+
+// IsFeatureActive returns true if the given feature has been scheduled by
+// governance and the current block height has reached its activation height.
+// Features that have never been scheduled are inactive.
+func (k Keeper) IsFeatureActive(ctx sdk.Context, name string) bool {
+	gate, found := k.GetFeatureGate(ctx, name)
+	return found && gate.IsActive(ctx.BlockHeight())
+}
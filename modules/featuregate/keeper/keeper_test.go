@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/featuregate/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestSetGetParamSet() {
+	params := types.NewParams([]types.FeatureGate{types.NewFeatureGate("batch-dispatch", 100)})
+	suite.app.FeatureGateKeeper.SetParamSet(suite.ctx, params)
+	expParamSet := suite.app.FeatureGateKeeper.GetParamSet(suite.ctx)
+
+	require.Equal(suite.T(), params, expParamSet)
+}
+
+func (suite *KeeperTestSuite) TestIsFeatureActive() {
+	params := types.NewParams([]types.FeatureGate{
+		types.NewFeatureGate("fee-refund", 10),
+		types.NewFeatureGate("batch-dispatch", 11),
+	})
+	suite.app.FeatureGateKeeper.SetParamSet(suite.ctx, params)
+
+	require.True(suite.T(), suite.app.FeatureGateKeeper.IsFeatureActive(suite.ctx, "fee-refund"))
+	require.False(suite.T(), suite.app.FeatureGateKeeper.IsFeatureActive(suite.ctx, "batch-dispatch"))
+	require.False(suite.T(), suite.app.FeatureGateKeeper.IsFeatureActive(suite.ctx, "unknown"))
+
+	ctx := suite.ctx.WithBlockHeight(11)
+	require.True(suite.T(), suite.app.FeatureGateKeeper.IsFeatureActive(ctx, "batch-dispatch"))
+}
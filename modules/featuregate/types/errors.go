@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// featuregate module sentinel errors
+var (
+	ErrInvalidFeatureGate = sdkerrors.Register(ModuleName, 2, "invalid feature gate")
+	ErrUnknownFeature     = sdkerrors.Register(ModuleName, 3, "unknown feature")
+)
@@ -0,0 +1,20 @@
+package types
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided featuregate genesis state
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.Validate()
+}
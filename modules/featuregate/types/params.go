@@ -0,0 +1,152 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	"gopkg.in/yaml.v2"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store key
+var (
+	// params store for the scheduled feature gates
+	KeyGates = []byte("Gates")
+
+	// feature names are lower-case words separated by dashes, e.g. "repeated-service-invocation"
+	reFeatureName = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+)
+
+const (
+	// MaxFeatureNameLength is the maximum length of a feature name
+	MaxFeatureNameLength = 64
+)
+
+// ParamKeyTable for featuregate module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewFeatureGate constructs a FeatureGate
+func NewFeatureGate(name string, activationHeight int64) FeatureGate {
+	return FeatureGate{
+		Name:             name,
+		ActivationHeight: activationHeight,
+	}
+}
+
+// IsActive returns true if the feature is active at the given height
+func (g FeatureGate) IsActive(height int64) bool {
+	return height >= g.ActivationHeight
+}
+
+// Validate returns err if the FeatureGate is invalid
+func (g FeatureGate) Validate() error {
+	if err := ValidateFeatureName(g.Name); err != nil {
+		return err
+	}
+	if g.ActivationHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidFeatureGate, "activation height of feature [%s] must be positive, got %d", g.Name, g.ActivationHeight)
+	}
+	return nil
+}
+
+// NewParams constructs Params
+func NewParams(gates []FeatureGate) Params {
+	return Params{
+		Gates: gates,
+	}
+}
+
+// DefaultParams returns default featuregate module parameters
+func DefaultParams() Params {
+	return NewParams(nil)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyGates, &p.Gates, validateGates),
+	}
+}
+
+// GetParamSpace implements params.ParamStruct
+func (p *Params) GetParamSpace() string {
+	return DefaultParamSpace
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	return validateGates(p.Gates)
+}
+
+// GetGate returns the gate of the given feature
+func (p Params) GetGate(name string) (FeatureGate, bool) {
+	for _, gate := range p.Gates {
+		if gate.Name == name {
+			return gate, true
+		}
+	}
+	return FeatureGate{}, false
+}
+
+// ValidateGateUpdate verifies that updating the gates at the given height keeps
+// the gates already active there: an active feature may have written state its
+// code relies on, so it can be neither removed nor rescheduled
+func ValidateGateUpdate(height int64, gates, updated []FeatureGate) error {
+	updatedParams := NewParams(updated)
+	for _, gate := range gates {
+		if !gate.IsActive(height) {
+			continue
+		}
+		updatedGate, found := updatedParams.GetGate(gate.Name)
+		if !found {
+			return sdkerrors.Wrapf(ErrInvalidFeatureGate, "active feature [%s] can't be removed", gate.Name)
+		}
+		if updatedGate.ActivationHeight != gate.ActivationHeight {
+			return sdkerrors.Wrapf(ErrInvalidFeatureGate, "active feature [%s] can't be rescheduled", gate.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateFeatureName verifies whether the given feature name is legal
+func ValidateFeatureName(name string) error {
+	if len(name) == 0 || len(name) > MaxFeatureNameLength || !reFeatureName.MatchString(name) {
+		return sdkerrors.Wrapf(ErrInvalidFeatureGate, "invalid feature name [%s], only lower-case letters, digits and dashes are allowed, length [1, %d]", name, MaxFeatureNameLength)
+	}
+	return nil
+}
+
+func validateGates(i interface{}) error {
+	v, ok := i.([]FeatureGate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	names := make(map[string]bool, len(v))
+	for _, gate := range v {
+		if err := gate.Validate(); err != nil {
+			return err
+		}
+		if names[gate.Name] {
+			return sdkerrors.Wrapf(ErrInvalidFeatureGate, "duplicate feature [%s]", gate.Name)
+		}
+		names[gate.Name] = true
+	}
+
+	return nil
+}
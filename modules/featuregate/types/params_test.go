@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"valid gates", NewParams([]FeatureGate{NewFeatureGate("repeated-service-invocation", 100), NewFeatureGate("batch-dispatch", 1)}), true},
+		{"empty name", NewParams([]FeatureGate{NewFeatureGate("", 100)}), false},
+		{"upper case name", NewParams([]FeatureGate{NewFeatureGate("Batch-Dispatch", 100)}), false},
+		{"trailing dash", NewParams([]FeatureGate{NewFeatureGate("batch-", 100)}), false},
+		{"zero height", NewParams([]FeatureGate{NewFeatureGate("batch-dispatch", 0)}), false},
+		{"negative height", NewParams([]FeatureGate{NewFeatureGate("batch-dispatch", -1)}), false},
+		{"duplicate name", NewParams([]FeatureGate{NewFeatureGate("batch-dispatch", 1), NewFeatureGate("batch-dispatch", 2)}), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestFeatureGateIsActive(t *testing.T) {
+	gate := NewFeatureGate("batch-dispatch", 100)
+	require.False(t, gate.IsActive(99))
+	require.True(t, gate.IsActive(100))
+	require.True(t, gate.IsActive(101))
+}
+
+func TestValidateGateUpdate(t *testing.T) {
+	active := NewFeatureGate("batch-dispatch", 100)
+	scheduled := NewFeatureGate("repeated-service-invocation", 200)
+	gates := []FeatureGate{active, scheduled}
+
+	tests := []struct {
+		name    string
+		updated []FeatureGate
+		expPass bool
+	}{
+		{"unchanged", gates, true},
+		{"new gate", append([]FeatureGate{NewFeatureGate("text-validation", 300)}, gates...), true},
+		{"scheduled gate removed", []FeatureGate{active}, true},
+		{"scheduled gate rescheduled", []FeatureGate{active, NewFeatureGate(scheduled.Name, 300)}, true},
+		{"active gate removed", []FeatureGate{scheduled}, false},
+		{"active gate rescheduled", []FeatureGate{NewFeatureGate(active.Name, 150), scheduled}, false},
+		{"all gates removed", nil, false},
+	}
+
+	for _, tc := range tests {
+		err := ValidateGateUpdate(150, gates, tc.updated)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
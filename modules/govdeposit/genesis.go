@@ -0,0 +1,29 @@
+package govdeposit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/govdeposit/keeper"
+	"github.com/irisnet/irishub/modules/govdeposit/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize govdeposit genesis state: %s", err.Error()))
+	}
+	keeper.SetParamSet(ctx, data.Params)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(keeper.GetParamSet(ctx))
+}
+
+// ValidateGenesis performs basic validation of govdeposit genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/govdeposit/types"
+)
+
+// Keeper of the govdeposit store
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+	bankKeeper types.BankKeeper
+	govKeeper  types.GovKeeper
+}
+
+// NewKeeper returns a govdeposit keeper
+func NewKeeper(
+	paramSpace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	govKeeper types.GovKeeper,
+) Keeper {
+	return Keeper{
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		bankKeeper: bankKeeper,
+		govKeeper:  govKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GetParamSet returns govdeposit params from the global param store.
+// The params may be absent on chains that added the module by an
+// upgrade, in which case the defaults apply.
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyQuorumMissedBurnRate, &params.QuorumMissedBurnRate)
+	return params
+}
+
+// SetParamSet sets govdeposit params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetOutcome returns the outcome of the vote of a proposal whose voting period
+// ends, as tallied by gov. Tallying deletes the votes, so the tallies run on
+// discarded cache contexts: the gov tally tells whether the proposal passes and
+// whether its deposits are burned, and a tally with the veto disabled tells a
+// missed quorum, the other cause of a burn, from a veto.
+func (k Keeper) GetOutcome(ctx sdk.Context, proposal govtypes.Proposal) types.Outcome {
+	cacheCtx, _ := ctx.CacheContext()
+	passes, burnDeposits, _ := k.govKeeper.Tally(cacheCtx, proposal)
+	switch {
+	case passes:
+		return types.OutcomePassed
+	case !burnDeposits:
+		return types.OutcomeRejected
+	}
+
+	cacheCtx, _ = ctx.CacheContext()
+	tallyParams := k.govKeeper.GetTallyParams(cacheCtx)
+	tallyParams.VetoThreshold = sdk.OneDec()
+	k.govKeeper.SetTallyParams(cacheCtx, tallyParams)
+	if _, burnDeposits, _ = k.govKeeper.Tally(cacheCtx, proposal); burnDeposits {
+		return types.OutcomeQuorumMissed
+	}
+	return types.OutcomeVetoed
+}
+
+// PrepareSettlement settles the deposits of a proposal whose voting period ends,
+// before the gov end blocker tallies it. Gov refunds the deposits, or burns them
+// when the vote is vetoed or misses the quorum; when the quorum is missed, the
+// share of the deposits not to burn is refunded here and gov burns the rest.
+// If the refund fails, gov burns all the deposits.
+func (k Keeper) PrepareSettlement(ctx sdk.Context, proposal govtypes.Proposal) types.Settlement {
+	total := sdk.NewCoins()
+	for _, deposit := range k.govKeeper.GetDeposits(ctx, proposal.ProposalId) {
+		total = total.Add(deposit.Amount...)
+	}
+
+	settlement := types.Settlement{
+		ProposalID: proposal.ProposalId,
+		Outcome:    k.GetOutcome(ctx, proposal),
+		Refunded:   total,
+		Burned:     sdk.NewCoins(),
+	}
+
+	switch settlement.Outcome {
+	case types.OutcomeVetoed:
+		settlement.Refunded, settlement.Burned = sdk.NewCoins(), total
+
+	case types.OutcomeQuorumMissed:
+		cacheCtx, writeCache := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+		refunded, err := k.refundUnburnedDeposits(cacheCtx, proposal.ProposalId)
+		if err != nil {
+			k.Logger(ctx).Error("failed to refund the proposal deposits", "proposal", proposal.ProposalId, "err", err)
+			settlement.Refunded, settlement.Burned = sdk.NewCoins(), total
+			break
+		}
+
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+		settlement.Refunded, settlement.Burned = refunded, total.Sub(refunded)
+	}
+	return settlement
+}
+
+// CompleteSettlement reports the settlement of the deposits of a proposal once
+// the gov end blocker has tallied it. A passing proposal fails if its execution
+// does, which only its stored status tells.
+func (k Keeper) CompleteSettlement(ctx sdk.Context, settlement types.Settlement) {
+	if settlement.Outcome == types.OutcomePassed {
+		if proposal, found := k.govKeeper.GetProposal(ctx, settlement.ProposalID); found && proposal.Status == govtypes.StatusFailed {
+			settlement.Outcome = types.OutcomeFailed
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalDeposits,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", settlement.ProposalID)),
+			sdk.NewAttribute(types.AttributeKeyOutcome, string(settlement.Outcome)),
+			sdk.NewAttribute(types.AttributeKeyRefunded, settlement.Refunded.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, settlement.Burned.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"proposal deposits settled",
+		"proposal", settlement.ProposalID,
+		"outcome", settlement.Outcome,
+		"refunded", settlement.Refunded.String(),
+		"burned", settlement.Burned.String(),
+	)
+}
+
+// refundUnburnedDeposits refunds the share of the deposits of a proposal missing
+// the quorum which is not burned, leaving the rest deposited for gov to burn
+func (k Keeper) refundUnburnedDeposits(ctx sdk.Context, proposalID uint64) (sdk.Coins, error) {
+	burnRate := k.GetParamSet(ctx).BurnRate(types.OutcomeQuorumMissed)
+
+	refunded := sdk.NewCoins()
+	for _, deposit := range k.govKeeper.GetDeposits(ctx, proposalID) {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return nil, err
+		}
+
+		burn := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			burn = burn.Add(sdk.NewCoin(coin.Denom, burnRate.MulInt(coin.Amount).TruncateInt()))
+		}
+		refund := deposit.Amount.Sub(burn)
+		if refund.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, govtypes.ModuleName, depositor, refund); err != nil {
+			return nil, err
+		}
+		deposit.Amount = burn
+		k.govKeeper.SetDeposit(ctx, deposit)
+
+		refunded = refunded.Add(refund...)
+	}
+	return refunded, nil
+}
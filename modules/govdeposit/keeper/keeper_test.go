@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/irisnet/irishub/modules/govdeposit/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	depositor1 = sdk.AccAddress([]byte("govdeposit-depositor"))
+	depositor2 = sdk.AccAddress([]byte("govdeposit-second-dp"))
+	validator  = sdk.ValAddress([]byte("govdeposit-validator"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	suite.Equal(types.DefaultParams(), suite.app.GovDepositKeeper.GetParamSet(suite.ctx))
+
+	params := types.NewParams(sdk.NewDecWithPrec(2, 1))
+	suite.app.GovDepositKeeper.SetParamSet(suite.ctx, params)
+	suite.Equal(params, suite.app.GovDepositKeeper.GetParamSet(suite.ctx))
+
+	res, err := suite.app.GovDepositKeeper.Params(sdk.WrapSDKContext(suite.ctx), &types.QueryParamsRequest{})
+	suite.NoError(err)
+	suite.Equal(params, res.Params)
+}
+
+// setupProposal submits a proposal in its voting period with the deposits of
+// two depositors, voted by a validator holding all the bonded tokens unless
+// the option is empty
+func (suite *KeeperTestSuite) setupProposal(ctx sdk.Context, option govtypes.VoteOption) govtypes.Proposal {
+	deposits := []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 333)),
+	}
+	minDeposit := govtypes.NewDepositParams(deposits[0], govtypes.DefaultPeriod)
+	suite.app.GovKeeper.SetDepositParams(ctx, minDeposit)
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(ctx, govtypes.NewTextProposal("title", "description"))
+	suite.Require().NoError(err)
+	for i, depositor := range []sdk.AccAddress{depositor1, depositor2} {
+		suite.Require().NoError(suite.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, deposits[i]))
+		suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, depositor, deposits[i]))
+		_, err := suite.app.GovKeeper.AddDeposit(ctx, proposal.ProposalId, depositor, deposits[i])
+		suite.Require().NoError(err)
+	}
+
+	if option != govtypes.OptionEmpty {
+		suite.Require().NoError(suite.app.GovKeeper.AddVote(ctx, proposal.ProposalId, sdk.AccAddress(validator), option))
+	}
+
+	proposal, _ = suite.app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	suite.Require().Equal(govtypes.StatusVotingPeriod, proposal.Status)
+	return proposal
+}
+
+// setupValidator creates and bonds a validator holding all the bonded tokens
+func (suite *KeeperTestSuite) setupValidator() {
+	tokens := sdk.TokensFromConsensusPower(10)
+	selfDelegation := sdk.NewCoin(sdk.DefaultBondDenom, tokens)
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.NewCoins(selfDelegation)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sdk.AccAddress(validator), sdk.NewCoins(selfDelegation)))
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		validator, ed25519.GenPrivKey().PubKey(), selfDelegation, stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	suite.Require().NoError(err)
+	_, err = stakingkeeper.NewMsgServerImpl(suite.app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	staking.EndBlocker(suite.ctx, suite.app.StakingKeeper)
+	suite.Require().Equal(tokens, suite.app.StakingKeeper.TotalBondedTokens(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestSettlement() {
+	suite.setupValidator()
+	suite.app.GovDepositKeeper.SetParamSet(suite.ctx, types.NewParams(sdk.NewDecWithPrec(3, 1)))
+
+	testCases := []struct {
+		name     string
+		option   govtypes.VoteOption
+		outcome  types.Outcome
+		refunded [2]int64
+	}{
+		{"passed", govtypes.OptionYes, types.OutcomePassed, [2]int64{1000, 333}},
+		{"rejected", govtypes.OptionNo, types.OutcomeRejected, [2]int64{1000, 333}},
+		{"vetoed", govtypes.OptionNoWithVeto, types.OutcomeVetoed, [2]int64{0, 0}},
+		{"quorum missed", govtypes.OptionEmpty, types.OutcomeQuorumMissed, [2]int64{700, 234}},
+	}
+	for _, tc := range testCases {
+		ctx, _ := suite.ctx.CacheContext()
+		proposal := suite.setupProposal(ctx, tc.option)
+		supplyBefore := suite.app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom)
+
+		suite.Equal(tc.outcome, suite.app.GovDepositKeeper.GetOutcome(ctx, proposal), tc.name)
+		// the votes are left for gov to tally
+		suite.Equal(tc.option != govtypes.OptionEmpty, len(suite.app.GovKeeper.GetVotes(ctx, proposal.ProposalId)) == 1, tc.name)
+
+		// the gov module of the app settles the deposits
+		res := suite.app.EndBlocker(ctx.WithBlockTime(proposal.VotingEndTime), abci.RequestEndBlock{})
+
+		refunded := tc.refunded[0] + tc.refunded[1]
+		suite.Equal(tc.refunded[0], suite.app.BankKeeper.GetBalance(ctx, depositor1, sdk.DefaultBondDenom).Amount.Int64(), tc.name)
+		suite.Equal(tc.refunded[1], suite.app.BankKeeper.GetBalance(ctx, depositor2, sdk.DefaultBondDenom).Amount.Int64(), tc.name)
+		suite.Equal(
+			supplyBefore.SubRaw(1333-refunded),
+			suite.app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.DefaultBondDenom), tc.name,
+		)
+		suite.Empty(suite.app.GovKeeper.GetDeposits(ctx, proposal.ProposalId), tc.name)
+
+		var event abci.Event
+		for _, e := range res.Events {
+			if e.Type == types.EventTypeProposalDeposits {
+				event = e
+			}
+		}
+		suite.Equal([]abci.EventAttribute{
+			{Key: []byte(types.AttributeKeyProposalID), Value: []byte(fmt.Sprintf("%d", proposal.ProposalId))},
+			{Key: []byte(types.AttributeKeyOutcome), Value: []byte(tc.outcome)},
+			{Key: []byte(types.AttributeKeyRefunded), Value: []byte(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, refunded)).String())},
+			{Key: []byte(types.AttributeKeyBurned), Value: []byte(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1333-refunded)).String())},
+		}, event.Attributes, tc.name)
+	}
+}
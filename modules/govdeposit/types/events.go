@@ -0,0 +1,11 @@
+package types
+
+// govdeposit module event types and attributes
+const (
+	EventTypeProposalDeposits = "proposal_deposits"
+
+	AttributeKeyProposalID = "proposal_id"
+	AttributeKeyOutcome    = "outcome"
+	AttributeKeyRefunded   = "refunded"
+	AttributeKeyBurned     = "burned"
+)
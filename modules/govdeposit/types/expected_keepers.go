@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// BankKeeper defines the expected bank keeper (noalias)
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// GovKeeper defines the expected gov keeper (noalias)
+type GovKeeper interface {
+	GetProposal(ctx sdk.Context, proposalID uint64) (govtypes.Proposal, bool)
+	GetDeposits(ctx sdk.Context, proposalID uint64) govtypes.Deposits
+	SetDeposit(ctx sdk.Context, deposit govtypes.Deposit)
+	GetTallyParams(ctx sdk.Context) govtypes.TallyParams
+	SetTallyParams(ctx sdk.Context, tallyParams govtypes.TallyParams)
+	Tally(ctx sdk.Context, proposal govtypes.Proposal) (passes bool, burnDeposits bool, tallyResults govtypes.TallyResult)
+}
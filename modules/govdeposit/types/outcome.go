@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Outcome is the outcome of the vote of a proposal which decides the handling of its deposits
+type Outcome string
+
+// proposal outcomes
+const (
+	OutcomePassed       Outcome = "passed"
+	OutcomeFailed       Outcome = "failed"
+	OutcomeRejected     Outcome = "rejected"
+	OutcomeVetoed       Outcome = "vetoed"
+	OutcomeQuorumMissed Outcome = "quorum_missed"
+)
+
+// Settlement is the handling of the deposits of a proposal whose voting period ends
+type Settlement struct {
+	ProposalID uint64
+	Outcome    Outcome
+	Refunded   sdk.Coins
+	Burned     sdk.Coins
+}
+
+// BurnRate returns the share of the deposits burned for the given outcome: none on
+// pass or fail, all on veto and the governed share when the quorum is missed
+func (p Params) BurnRate(outcome Outcome) sdk.Dec {
+	switch outcome {
+	case OutcomeVetoed:
+		return sdk.OneDec()
+	case OutcomeQuorumMissed:
+		return p.QuorumMissedBurnRate
+	default:
+		return sdk.ZeroDec()
+	}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store key
+var (
+	// params store for the share of the deposits burned when the quorum is missed
+	KeyQuorumMissedBurnRate = []byte("QuorumMissedBurnRate")
+)
+
+// ParamKeyTable for govdeposit module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs Params
+func NewParams(quorumMissedBurnRate sdk.Dec) Params {
+	return Params{
+		QuorumMissedBurnRate: quorumMissedBurnRate,
+	}
+}
+
+// DefaultParams returns default govdeposit module parameters. The deposits of
+// the proposals missing the quorum are burned in full, as by the gov module.
+func DefaultParams() Params {
+	return NewParams(sdk.OneDec())
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyQuorumMissedBurnRate, &p.QuorumMissedBurnRate, validateQuorumMissedBurnRate),
+	}
+}
+
+// GetParamSpace implements params.ParamStruct
+func (p *Params) GetParamSpace() string {
+	return DefaultParamSpace
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	return validateQuorumMissedBurnRate(p.QuorumMissedBurnRate)
+}
+
+func validateQuorumMissedBurnRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("quorum missed burn rate must be between 0 and 1: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"no burn", NewParams(sdk.ZeroDec()), true},
+		{"full burn", NewParams(sdk.OneDec()), true},
+		{"nil rate", Params{}, false},
+		{"negative rate", NewParams(sdk.NewDecWithPrec(-1, 1)), false},
+		{"rate above one", NewParams(sdk.NewDecWithPrec(11, 1)), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestBurnRate(t *testing.T) {
+	params := NewParams(sdk.NewDecWithPrec(3, 1))
+	require.Equal(t, sdk.ZeroDec(), params.BurnRate(OutcomePassed))
+	require.Equal(t, sdk.ZeroDec(), params.BurnRate(OutcomeFailed))
+	require.Equal(t, sdk.ZeroDec(), params.BurnRate(OutcomeRejected))
+	require.Equal(t, sdk.OneDec(), params.BurnRate(OutcomeVetoed))
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), params.BurnRate(OutcomeQuorumMissed))
+}
@@ -0,0 +1,39 @@
+package govquorum
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/govquorum/keeper"
+	"github.com/irisnet/irishub/modules/govquorum/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize govquorum genesis state: %s", err.Error()))
+	}
+
+	k.SetParamSet(ctx, data.Params)
+	for _, failures := range data.QuorumFailures {
+		k.SetQuorumFailures(ctx, failures)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var quorumFailures []types.QuorumFailures
+	k.IterateQuorumFailures(ctx, func(failures types.QuorumFailures) bool {
+		quorumFailures = append(quorumFailures, failures)
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParamSet(ctx), quorumFailures)
+}
+
+// ValidateGenesis performs basic validation of govquorum genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
@@ -0,0 +1,69 @@
+package govquorum_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/govquorum"
+	"github.com/irisnet/irishub/modules/govquorum/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) TestExportGenesis() {
+	defaultGenesis := types.DefaultGenesisState()
+	exportedGenesis := govquorum.ExportGenesis(suite.ctx, suite.app.GovQuorumKeeper)
+	suite.Equal(defaultGenesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestInitGenesis() {
+	hash := sha256.Sum256([]byte("content"))
+	genesis := types.NewGenesisState(
+		types.NewParams(2, sdk.NewDecWithPrec(25, 2), time.Hour),
+		[]types.QuorumFailures{{
+			ContentHash:     hex.EncodeToString(hash[:]),
+			Count:           1,
+			LastFailureTime: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		}},
+	)
+	govquorum.InitGenesis(suite.ctx, suite.app.GovQuorumKeeper, *genesis)
+
+	exportedGenesis := govquorum.ExportGenesis(suite.ctx, suite.app.GovQuorumKeeper)
+	suite.Equal(genesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestValidateGenesis() {
+	hash := sha256.Sum256([]byte("content"))
+	failures := types.QuorumFailures{ContentHash: hex.EncodeToString(hash[:]), Count: 1}
+
+	suite.NoError(govquorum.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), []types.QuorumFailures{failures})))
+	suite.Error(govquorum.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), []types.QuorumFailures{failures, failures})))
+	suite.Error(govquorum.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), []types.QuorumFailures{{ContentHash: "00", Count: 1}})))
+	suite.Error(govquorum.ValidateGenesis(*types.NewGenesisState(types.DefaultParams(), []types.QuorumFailures{{ContentHash: failures.ContentHash}})))
+	suite.Error(govquorum.ValidateGenesis(*types.NewGenesisState(types.NewParams(1, sdk.ZeroDec(), time.Hour), nil)))
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/govquorum/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the govquorum parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// QuorumFailures queries the quorum failures of the content of a proposal
+func (k Keeper) QuorumFailures(c context.Context, req *types.QueryQuorumFailuresRequest) (*types.QueryQuorumFailuresResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res, err := k.queryQuorumFailures(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// queryQuorumFailures returns the quorum failures of the content of a proposal,
+// none if the content did not miss the quorum, and whether the proposal would
+// be tallied with the reduced quorum in the current block
+func (k Keeper) queryQuorumFailures(ctx sdk.Context, proposalID uint64) (types.QueryQuorumFailuresResponse, error) {
+	proposal, found := k.govKeeper.GetProposal(ctx, proposalID)
+	if !found {
+		return types.QueryQuorumFailuresResponse{}, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	hash := types.ContentHash(proposal)
+	failures, found := k.GetQuorumFailures(ctx, hash)
+	if !found {
+		failures = types.QuorumFailures{ContentHash: hex.EncodeToString(hash)}
+	}
+	_, reduced := k.ReducedQuorum(ctx, proposal)
+
+	return types.QueryQuorumFailuresResponse{QuorumFailures: failures, ReducedQuorum: reduced}, nil
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/govquorum/types"
+)
+
+// Keeper of the govquorum store
+type Keeper struct {
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+	govKeeper  types.GovKeeper
+}
+
+// NewKeeper returns a govquorum keeper
+func NewKeeper(
+	cdc codec.Marshaler,
+	key sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	govKeeper types.GovKeeper,
+) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		govKeeper:  govKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GetParamSet returns govquorum params from the global param store.
+// The params may be absent on chains that added the module by an
+// upgrade, in which case the defaults apply.
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxQuorumFailures, &params.MaxQuorumFailures)
+	k.paramSpace.GetIfExists(ctx, types.KeyReducedQuorum, &params.ReducedQuorum)
+	k.paramSpace.GetIfExists(ctx, types.KeyWindow, &params.Window)
+	return params
+}
+
+// SetParamSet sets govquorum params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// ReducedQuorum returns the quorum of a proposal whose voting period ends in
+// the current block if a reduced quorum window is open for its content: the
+// identical proposals missed the quorum the set number of consecutive times,
+// the last one within the window.
+func (k Keeper) ReducedQuorum(ctx sdk.Context, proposal govtypes.Proposal) (sdk.Dec, bool) {
+	params := k.GetParamSet(ctx)
+	if !params.IsEnabled() {
+		return sdk.Dec{}, false
+	}
+
+	failures, found := k.GetQuorumFailures(ctx, types.ContentHash(proposal))
+	if !found || failures.Count < params.MaxQuorumFailures || k.isExpired(ctx, params, failures) {
+		return sdk.Dec{}, false
+	}
+	return params.ReducedQuorum, true
+}
+
+// RecordOutcome records whether a tallied proposal missed the quorum. A missed
+// quorum counts as a consecutive failure of its content unless the previous
+// one is out of the window, and any other outcome closes the window. Nothing
+// is recorded while the windows are disabled.
+func (k Keeper) RecordOutcome(ctx sdk.Context, proposal govtypes.Proposal, quorumMissed bool) {
+	params := k.GetParamSet(ctx)
+	hash := types.ContentHash(proposal)
+
+	k.pruneQuorumFailures(ctx, params)
+
+	if !quorumMissed {
+		k.DeleteQuorumFailures(ctx, hash)
+		return
+	}
+	if !params.IsEnabled() {
+		return
+	}
+
+	failures, found := k.GetQuorumFailures(ctx, hash)
+	if !found {
+		failures = types.QuorumFailures{ContentHash: hex.EncodeToString(hash)}
+	}
+	failures.Count++
+	failures.LastFailureTime = ctx.BlockTime()
+	k.SetQuorumFailures(ctx, failures)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuorumFailure,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyContentHash, failures.ContentHash),
+			sdk.NewAttribute(types.AttributeKeyCount, fmt.Sprintf("%d", failures.Count)),
+		),
+	)
+}
+
+// GetQuorumFailures returns the quorum failures of the given content hash
+func (k Keeper) GetQuorumFailures(ctx sdk.Context, contentHash []byte) (failures types.QuorumFailures, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetQuorumFailuresKey(contentHash))
+	if bz == nil {
+		return failures, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &failures)
+	return failures, true
+}
+
+// SetQuorumFailures stores the quorum failures of a content
+func (k Keeper) SetQuorumFailures(ctx sdk.Context, failures types.QuorumFailures) {
+	hash, err := hex.DecodeString(failures.ContentHash)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&failures)
+	store.Set(types.GetQuorumFailuresKey(hash), bz)
+}
+
+// DeleteQuorumFailures deletes the quorum failures of the given content hash
+func (k Keeper) DeleteQuorumFailures(ctx sdk.Context, contentHash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQuorumFailuresKey(contentHash))
+}
+
+// IterateQuorumFailures iterates through all the quorum failures
+func (k Keeper) IterateQuorumFailures(ctx sdk.Context, op func(failures types.QuorumFailures) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.QuorumFailuresKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var failures types.QuorumFailures
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &failures)
+
+		if stop := op(failures); stop {
+			break
+		}
+	}
+}
+
+// isExpired returns true if the last quorum failure is out of the window
+func (k Keeper) isExpired(ctx sdk.Context, params types.Params, failures types.QuorumFailures) bool {
+	return ctx.BlockTime().After(failures.LastFailureTime.Add(params.Window))
+}
+
+// pruneQuorumFailures deletes the quorum failures out of the window, which no
+// longer count
+func (k Keeper) pruneQuorumFailures(ctx sdk.Context, params types.Params) {
+	var expired []types.QuorumFailures
+	k.IterateQuorumFailures(ctx, func(failures types.QuorumFailures) bool {
+		if k.isExpired(ctx, params, failures) {
+			expired = append(expired, failures)
+		}
+		return false
+	})
+
+	for _, failures := range expired {
+		hash, _ := hex.DecodeString(failures.ContentHash)
+		k.DeleteQuorumFailures(ctx, hash)
+	}
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/irisnet/irishub/modules/govquorum/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	depositor = sdk.AccAddress([]byte("govquorum-depositor-"))
+	voter     = sdk.ValAddress([]byte("govquorum-voter-vali"))
+	absentee  = sdk.ValAddress([]byte("govquorum-absent-val"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	suite.Equal(types.DefaultParams(), suite.app.GovQuorumKeeper.GetParamSet(suite.ctx))
+
+	params := types.NewParams(3, sdk.NewDecWithPrec(1, 1), time.Hour)
+	suite.app.GovQuorumKeeper.SetParamSet(suite.ctx, params)
+	suite.Equal(params, suite.app.GovQuorumKeeper.GetParamSet(suite.ctx))
+
+	res, err := suite.app.GovQuorumKeeper.Params(sdk.WrapSDKContext(suite.ctx), &types.QueryParamsRequest{})
+	suite.NoError(err)
+	suite.Equal(params, res.Params)
+}
+
+// setupValidator creates and bonds a validator with the given power
+func (suite *KeeperTestSuite) setupValidator(validator sdk.ValAddress, power int64) {
+	selfDelegation := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(power))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.NewCoins(selfDelegation)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sdk.AccAddress(validator), sdk.NewCoins(selfDelegation)))
+
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		validator, ed25519.GenPrivKey().PubKey(), selfDelegation, stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	suite.Require().NoError(err)
+	_, err = stakingkeeper.NewMsgServerImpl(suite.app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	staking.EndBlocker(suite.ctx, suite.app.StakingKeeper)
+}
+
+// tallyProposal submits a text proposal with the given description, voted by a
+// validator holding a quarter of the bonded tokens, and tallies it at the end
+// of its voting period
+func (suite *KeeperTestSuite) tallyProposal(description string) (govtypes.Proposal, abci.ResponseEndBlock) {
+	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, minDeposit))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, depositor, minDeposit))
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, govtypes.NewTextProposal("title", description))
+	suite.Require().NoError(err)
+	_, err = suite.app.GovKeeper.AddDeposit(suite.ctx, proposal.ProposalId, depositor, minDeposit)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.GovKeeper.AddVote(suite.ctx, proposal.ProposalId, sdk.AccAddress(voter), govtypes.OptionYes))
+
+	proposal, _ = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.ctx = suite.ctx.WithBlockTime(proposal.VotingEndTime)
+	res := suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
+
+	proposal, _ = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	return proposal, res
+}
+
+func hasEvent(res abci.ResponseEndBlock, eventType string) bool {
+	for _, e := range res.Events {
+		if e.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *KeeperTestSuite) TestReducedQuorum() {
+	suite.setupValidator(voter, 10)
+	suite.setupValidator(absentee, 30)
+
+	tallyParams := suite.app.GovKeeper.GetTallyParams(suite.ctx)
+	params := types.NewParams(2, sdk.NewDecWithPrec(2, 1), 14*24*time.Hour)
+	suite.app.GovQuorumKeeper.SetParamSet(suite.ctx, params)
+
+	// the identical proposals miss the quorum the set number of times
+	for i := uint32(1); i <= params.MaxQuorumFailures; i++ {
+		proposal, res := suite.tallyProposal("description")
+		suite.Equal(govtypes.StatusRejected, proposal.Status)
+		suite.False(hasEvent(res, types.EventTypeReducedQuorum))
+
+		failures, found := suite.app.GovQuorumKeeper.GetQuorumFailures(suite.ctx, types.ContentHash(proposal))
+		suite.True(found)
+		suite.Equal(i, failures.Count)
+		suite.Equal(hex.EncodeToString(types.ContentHash(proposal)), failures.ContentHash)
+		suite.Equal(suite.ctx.BlockTime(), failures.LastFailureTime)
+	}
+
+	// another content misses the quorum
+	other, _ := suite.tallyProposal("other description")
+	suite.Equal(govtypes.StatusRejected, other.Status)
+
+	// the next identical proposal meets the reduced quorum, which closes the window
+	proposal, res := suite.tallyProposal("description")
+	suite.Equal(govtypes.StatusPassed, proposal.Status)
+	suite.True(hasEvent(res, types.EventTypeReducedQuorum))
+	_, found := suite.app.GovQuorumKeeper.GetQuorumFailures(suite.ctx, types.ContentHash(proposal))
+	suite.False(found)
+	suite.Equal(tallyParams, suite.app.GovKeeper.GetTallyParams(suite.ctx))
+
+	res2, err := suite.app.GovQuorumKeeper.QuorumFailures(sdk.WrapSDKContext(suite.ctx), &types.QueryQuorumFailuresRequest{ProposalId: other.ProposalId})
+	suite.NoError(err)
+	suite.Equal(uint32(1), res2.QuorumFailures.Count)
+	suite.False(res2.ReducedQuorum)
+}
+
+func (suite *KeeperTestSuite) TestWindowExpiry() {
+	suite.setupValidator(voter, 10)
+	suite.setupValidator(absentee, 30)
+
+	// the window is shorter than the voting period of a re-submitted proposal
+	votingPeriod := suite.app.GovKeeper.GetVotingParams(suite.ctx).VotingPeriod
+	params := types.NewParams(1, sdk.NewDecWithPrec(2, 1), votingPeriod/2)
+	suite.app.GovQuorumKeeper.SetParamSet(suite.ctx, params)
+
+	for i := 0; i < 2; i++ {
+		proposal, res := suite.tallyProposal("description")
+		suite.Equal(govtypes.StatusRejected, proposal.Status)
+		suite.False(hasEvent(res, types.EventTypeReducedQuorum))
+
+		failures, found := suite.app.GovQuorumKeeper.GetQuorumFailures(suite.ctx, types.ContentHash(proposal))
+		suite.True(found)
+		suite.Equal(uint32(1), failures.Count)
+	}
+}
+
+func (suite *KeeperTestSuite) TestDisabled() {
+	suite.setupValidator(voter, 10)
+	suite.setupValidator(absentee, 30)
+
+	for i := 0; i < 3; i++ {
+		proposal, res := suite.tallyProposal("description")
+		suite.Equal(govtypes.StatusRejected, proposal.Status)
+		suite.False(hasEvent(res, types.EventTypeReducedQuorum))
+
+		_, found := suite.app.GovQuorumKeeper.GetQuorumFailures(suite.ctx, types.ContentHash(proposal))
+		suite.False(found)
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// govquorum module sentinel errors
+var (
+	ErrUnknownProposal = sdkerrors.Register(ModuleName, 2, "unknown proposal")
+)
@@ -0,0 +1,12 @@
+package types
+
+// govquorum module event types and attributes
+const (
+	EventTypeQuorumFailure = "quorum_failure"
+	EventTypeReducedQuorum = "reduced_quorum"
+
+	AttributeKeyProposalID  = "proposal_id"
+	AttributeKeyContentHash = "content_hash"
+	AttributeKeyCount       = "count"
+	AttributeKeyQuorum      = "quorum"
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(params Params, quorumFailures []QuorumFailures) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		QuorumFailures: quorumFailures,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the provided govquorum genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, failures := range data.QuorumFailures {
+		if err := failures.Validate(); err != nil {
+			return err
+		}
+		if seen[failures.ContentHash] {
+			return fmt.Errorf("duplicate quorum failures for content %s", failures.ContentHash)
+		}
+		seen[failures.ContentHash] = true
+	}
+	return nil
+}
+
+// Validate returns err if the QuorumFailures is invalid
+func (f QuorumFailures) Validate() error {
+	if hash, err := hex.DecodeString(f.ContentHash); err != nil || len(hash) != 32 {
+		return fmt.Errorf("invalid content hash: %s", f.ContentHash)
+	}
+	if f.Count == 0 {
+		return fmt.Errorf("quorum failures of content %s must be positive", f.ContentHash)
+	}
+	return nil
+}
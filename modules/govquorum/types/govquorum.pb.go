@@ -0,0 +1,682 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: govquorum/govquorum.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines govquorum module's parameters
+type Params struct {
+	// consecutive quorum failures of identical proposals opening a reduced quorum window, 0 disables the windows
+	MaxQuorumFailures uint32 `protobuf:"varint,1,opt,name=max_quorum_failures,json=maxQuorumFailures,proto3" json:"max_quorum_failures,omitempty" yaml:"max_quorum_failures"`
+	// quorum of the identical proposals whose voting period ends within an open window
+	ReducedQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reduced_quorum,json=reducedQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reduced_quorum" yaml:"reduced_quorum"`
+	// duration of a window from the last quorum failure
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4885263ad3879945, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxQuorumFailures() uint32 {
+	if m != nil {
+		return m.MaxQuorumFailures
+	}
+	return 0
+}
+
+func (m *Params) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// QuorumFailures defines the consecutive quorum failures of the proposals with the same content
+type QuorumFailures struct {
+	// hex encoded SHA-256 hash of the proposal content
+	ContentHash     string    `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty" yaml:"content_hash"`
+	Count           uint32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	LastFailureTime time.Time `protobuf:"bytes,3,opt,name=last_failure_time,json=lastFailureTime,proto3,stdtime" json:"last_failure_time" yaml:"last_failure_time"`
+}
+
+func (m *QuorumFailures) Reset()         { *m = QuorumFailures{} }
+func (m *QuorumFailures) String() string { return proto.CompactTextString(m) }
+func (*QuorumFailures) ProtoMessage()    {}
+func (*QuorumFailures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4885263ad3879945, []int{1}
+}
+func (m *QuorumFailures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumFailures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumFailures.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuorumFailures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumFailures.Merge(m, src)
+}
+func (m *QuorumFailures) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumFailures) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumFailures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumFailures proto.InternalMessageInfo
+
+func (m *QuorumFailures) GetContentHash() string {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ""
+}
+
+func (m *QuorumFailures) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QuorumFailures) GetLastFailureTime() time.Time {
+	if m != nil {
+		return m.LastFailureTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "irishub.govquorum.Params")
+	proto.RegisterType((*QuorumFailures)(nil), "irishub.govquorum.QuorumFailures")
+}
+
+func init() { proto.RegisterFile("govquorum/govquorum.proto", fileDescriptor_4885263ad3879945) }
+
+var fileDescriptor_4885263ad3879945 = []byte{
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x8e, 0x07, 0x54, 0xcc, 0xa3, 0x43, 0xcd, 0x86, 0xe8, 0x7a, 0x88, 0xab, 0x08, 0xa1, 0x5e,
+	0x88, 0xa5, 0x71, 0x2b, 0xb7, 0x68, 0x02, 0x0e, 0x08, 0x41, 0xc4, 0x89, 0x4b, 0xe5, 0x26, 0x5e,
+	0x1a, 0x11, 0xe7, 0x95, 0xd8, 0x66, 0xdb, 0x6f, 0xe0, 0xb2, 0xe3, 0x8e, 0xfc, 0x9c, 0x1d, 0x7b,
+	0x44, 0x1c, 0x02, 0x6a, 0xff, 0x41, 0x7f, 0x01, 0x72, 0xec, 0x8e, 0xb1, 0xed, 0x64, 0xbf, 0xf7,
+	0x3d, 0xfb, 0x7d, 0xdf, 0xf7, 0x1e, 0x3e, 0xc8, 0xe1, 0xdb, 0x57, 0x0d, 0xb5, 0x16, 0xf4, 0xea,
+	0x16, 0xcd, 0x6b, 0x50, 0xe0, 0xf7, 0x8a, 0xba, 0x90, 0x33, 0x3d, 0x8d, 0xae, 0x80, 0xc1, 0x7e,
+	0x0e, 0x39, 0xb4, 0x28, 0x35, 0x37, 0x5b, 0x38, 0x08, 0x72, 0x80, 0xbc, 0xe4, 0xb4, 0x8d, 0xa6,
+	0xfa, 0x98, 0x66, 0xba, 0x66, 0xaa, 0x80, 0xca, 0xe1, 0xe4, 0x26, 0xae, 0x0a, 0xc1, 0xa5, 0x62,
+	0x62, 0x6e, 0x0b, 0xc2, 0xef, 0x5b, 0xb8, 0xf3, 0x81, 0xd5, 0x4c, 0x48, 0xff, 0x3d, 0xde, 0x13,
+	0xec, 0x74, 0x62, 0xfb, 0x4d, 0x8e, 0x59, 0x51, 0xea, 0x9a, 0xcb, 0x3e, 0x1a, 0xa2, 0x51, 0x37,
+	0x0e, 0xd6, 0x0d, 0x19, 0x9c, 0x31, 0x51, 0x8e, 0xc3, 0x3b, 0x8a, 0xc2, 0xa4, 0x27, 0xd8, 0xe9,
+	0xc7, 0x36, 0xf9, 0xda, 0xe5, 0xfc, 0x0a, 0xef, 0xd6, 0x3c, 0xd3, 0x29, 0xcf, 0x5c, 0x79, 0x7f,
+	0x6b, 0x88, 0x46, 0xdb, 0xf1, 0x9b, 0xcb, 0x86, 0x78, 0xbf, 0x1a, 0xf2, 0x3c, 0x2f, 0x94, 0xd1,
+	0x98, 0x82, 0xa0, 0x29, 0x48, 0x01, 0xd2, 0x1d, 0x2f, 0x64, 0xf6, 0x85, 0xaa, 0xb3, 0x39, 0x97,
+	0xd1, 0x11, 0x4f, 0xd7, 0x0d, 0x79, 0x62, 0x1b, 0xff, 0xff, 0x5b, 0x98, 0x74, 0x5d, 0xc2, 0xf6,
+	0xf5, 0x5f, 0xe1, 0xce, 0x49, 0x51, 0x65, 0x70, 0xd2, 0xbf, 0x37, 0x44, 0xa3, 0x9d, 0xc3, 0x83,
+	0xc8, 0x8a, 0x8f, 0x36, 0xe2, 0xa3, 0x23, 0x67, 0x4e, 0xfc, 0xd0, 0x50, 0xb8, 0xf8, 0x4d, 0x50,
+	0xe2, 0x9e, 0x8c, 0xef, 0x5f, 0xfc, 0x20, 0x5e, 0xb8, 0x40, 0x78, 0xf7, 0x86, 0x8a, 0x31, 0x7e,
+	0x94, 0x42, 0xa5, 0x78, 0xa5, 0x26, 0x33, 0x26, 0x67, 0xad, 0x1d, 0xdb, 0xf1, 0xd3, 0x75, 0x43,
+	0xf6, 0x2c, 0xab, 0xeb, 0x68, 0x98, 0xec, 0xb8, 0xf0, 0x2d, 0x93, 0x33, 0x7f, 0x1f, 0x3f, 0x48,
+	0x41, 0x57, 0xaa, 0x15, 0xde, 0x4d, 0x6c, 0xe0, 0x97, 0xb8, 0x57, 0x32, 0xa9, 0x36, 0xe6, 0x4d,
+	0xcc, 0x48, 0x1c, 0xe5, 0xc1, 0x2d, 0xca, 0x9f, 0x36, 0xf3, 0x8a, 0x9f, 0x19, 0xce, 0xeb, 0x86,
+	0xf4, 0x6d, 0xdb, 0x5b, 0x5f, 0x84, 0xe7, 0x46, 0xcf, 0x63, 0x93, 0x77, 0xe4, 0xcd, 0xdb, 0xf8,
+	0xdd, 0xe5, 0x32, 0x40, 0x8b, 0x65, 0x80, 0xfe, 0x2c, 0x03, 0x74, 0xbe, 0x0a, 0xbc, 0xc5, 0x2a,
+	0xf0, 0x7e, 0xae, 0x02, 0xef, 0xf3, 0xe1, 0x35, 0xff, 0xcd, 0xbe, 0x55, 0x5c, 0x51, 0xb7, 0x77,
+	0x54, 0x40, 0xa6, 0x4b, 0x2e, 0xff, 0x2d, 0xa6, 0x9d, 0xc7, 0xb4, 0xd3, 0x12, 0x7b, 0xf9, 0x77,
+	0x00, 0xbd, 0x7b, 0x39, 0x10, 0xbc, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGovquorum(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ReducedQuorum.Size()
+		i -= size
+		if _, err := m.ReducedQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGovquorum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxQuorumFailures != 0 {
+		i = encodeVarintGovquorum(dAtA, i, uint64(m.MaxQuorumFailures))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumFailures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumFailures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumFailures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastFailureTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastFailureTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGovquorum(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Count != 0 {
+		i = encodeVarintGovquorum(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintGovquorum(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGovquorum(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGovquorum(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxQuorumFailures != 0 {
+		n += 1 + sovGovquorum(uint64(m.MaxQuorumFailures))
+	}
+	l = m.ReducedQuorum.Size()
+	n += 1 + l + sovGovquorum(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovGovquorum(uint64(l))
+	return n
+}
+
+func (m *QuorumFailures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovGovquorum(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovGovquorum(uint64(m.Count))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastFailureTime)
+	n += 1 + l + sovGovquorum(uint64(l))
+	return n
+}
+
+func sovGovquorum(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGovquorum(x uint64) (n int) {
+	return sovGovquorum(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovquorum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuorumFailures", wireType)
+			}
+			m.MaxQuorumFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovquorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQuorumFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReducedQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovquorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReducedQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovquorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovquorum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumFailures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovquorum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumFailures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumFailures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovquorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovquorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovquorum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastFailureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovquorum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovquorum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGovquorum(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGovquorum
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovquorum
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovquorum
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGovquorum
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGovquorum
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGovquorum
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGovquorum        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGovquorum          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGovquorum = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "govquorum"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the govquorum querier
+	QueryParameters     = "parameters"
+	QueryQuorumFailures = "quorum_failures"
+)
+
+var (
+	// Keys for store prefixes
+	QuorumFailuresKey = []byte{0x01} // prefix for the quorum failures of each proposal content
+)
+
+// GetQuorumFailuresKey returns the key of the quorum failures of the given content hash
+func GetQuorumFailuresKey(contentHash []byte) []byte {
+	return append(QuorumFailuresKey, contentHash...)
+}
+
+// ContentHash returns the SHA-256 hash of the content of a proposal, covering
+// both its type and its value, which identifies the re-submitted proposals
+func ContentHash(proposal govtypes.Proposal) []byte {
+	bz, err := proposal.Content.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store keys
+var (
+	// params store for the consecutive quorum failures opening a reduced quorum window
+	KeyMaxQuorumFailures = []byte("MaxQuorumFailures")
+	// params store for the quorum applied within a window
+	KeyReducedQuorum = []byte("ReducedQuorum")
+	// params store for the duration of a window
+	KeyWindow = []byte("Window")
+)
+
+// ParamKeyTable for govquorum module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs Params
+func NewParams(maxQuorumFailures uint32, reducedQuorum sdk.Dec, window time.Duration) Params {
+	return Params{
+		MaxQuorumFailures: maxQuorumFailures,
+		ReducedQuorum:     reducedQuorum,
+		Window:            window,
+	}
+}
+
+// DefaultParams returns default govquorum module parameters. No window opens
+// until governance sets the quorum failures opening one.
+func DefaultParams() Params {
+	return NewParams(0, sdk.NewDecWithPrec(2, 1), 14*24*time.Hour)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxQuorumFailures, &p.MaxQuorumFailures, validateMaxQuorumFailures),
+		paramtypes.NewParamSetPair(KeyReducedQuorum, &p.ReducedQuorum, validateReducedQuorum),
+		paramtypes.NewParamSetPair(KeyWindow, &p.Window, validateWindow),
+	}
+}
+
+// GetParamSpace implements params.ParamStruct
+func (p *Params) GetParamSpace() string {
+	return DefaultParamSpace
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateMaxQuorumFailures(p.MaxQuorumFailures); err != nil {
+		return err
+	}
+	if err := validateReducedQuorum(p.ReducedQuorum); err != nil {
+		return err
+	}
+	return validateWindow(p.Window)
+}
+
+// IsEnabled returns true if the reduced quorum windows can open
+func (p Params) IsEnabled() bool {
+	return p.MaxQuorumFailures > 0
+}
+
+func validateMaxQuorumFailures(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateReducedQuorum(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reduced quorum must be positive and at most 1: %s", v)
+	}
+	return nil
+}
+
+func validateWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("reduced quorum window must be positive: %s", v)
+	}
+	return nil
+}
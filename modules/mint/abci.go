@@ -21,9 +21,10 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 	// Calculate block mint amount
 	params := k.GetParamSet(ctx)
-	logger.Info("Mint parameters", "inflation_rate", params.Inflation.String(), "mint_denom", params.MintDenom)
+	blockTimeEstimate := k.BlockTime(ctx)
+	logger.Info("Mint parameters", "inflation_rate", params.Inflation.String(), "mint_denom", params.MintDenom, "block_time", blockTimeEstimate)
 
-	mintedCoin := minter.BlockProvision(params)
+	mintedCoin := minter.BlockProvision(params, blockTimeEstimate)
 	logger.Info("Mint result", "block_provisions", mintedCoin.String(), "time", blockTime.String())
 
 	mintedCoins := sdk.NewCoins(mintedCoin)
@@ -22,7 +22,7 @@ func TestBeginBlocker(t *testing.T) {
 	mint.BeginBlocker(ctx, app.MintKeeper)
 	minter := app.MintKeeper.GetMinter(ctx)
 	param := app.MintKeeper.GetParamSet(ctx)
-	mintCoins := minter.BlockProvision(param)
+	mintCoins := minter.BlockProvision(param, app.MintKeeper.BlockTime(ctx))
 
 	acc1 := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
 	mintedCoins := app.BankKeeper.GetAllBalances(ctx, acc1.GetAddress())
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -18,13 +19,15 @@ type Keeper struct {
 	storeKey         sdk.StoreKey
 	paramSpace       paramtypes.Subspace
 	bankKeeper       types.BankKeeper
+	blockTimeOracle  types.BlockTimeOracle
 	feeCollectorName string
 }
 
-// NewKeeper returns a mint keeper
+// NewKeeper returns a mint keeper. The block time oracle may be nil, in which
+// case the default block time is assumed.
 func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey,
 	paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string) Keeper {
+	bto types.BlockTimeOracle, feeCollectorName string) Keeper {
 
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -36,6 +39,7 @@ func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey,
 		cdc:              cdc,
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
 		bankKeeper:       bk,
+		blockTimeOracle:  bto,
 		feeCollectorName: feeCollectorName,
 	}
 	return keeper
@@ -46,6 +50,14 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
 }
 
+// BlockTime returns the block time the block provisions are computed with
+func (k Keeper) BlockTime(ctx sdk.Context) time.Duration {
+	if k.blockTimeOracle == nil {
+		return types.DefaultBlockTime
+	}
+	return k.blockTimeOracle.MedianBlockTime(ctx)
+}
+
 // ______________________________________________________________________
 
 // GetMinter returns the minter
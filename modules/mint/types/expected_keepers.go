@@ -1,6 +1,8 @@
 package types // noalias
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -21,3 +23,8 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
+
+// BlockTimeOracle defines the contract needed to measure the block time
+type BlockTimeOracle interface {
+	MedianBlockTime(ctx sdk.Context) time.Duration
+}
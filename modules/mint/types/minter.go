@@ -8,7 +8,10 @@ import (
 )
 
 const (
-	blocksPerYear = 60 * 60 * 8766 / 5 // 5 second a block, 8766 = 365.25 * 24
+	// DefaultBlockTime is the block time assumed when no block time oracle is set
+	DefaultBlockTime = 5 * time.Second
+
+	year = 8766 * time.Hour // 8766 = 365.25 * 24
 )
 
 var initialIssue = sdk.NewIntWithDecimal(20, 8)
@@ -45,9 +48,18 @@ func (m Minter) NextAnnualProvisions(params Params) (provisions sdk.Dec) {
 	return params.Inflation.MulInt(m.InflationBase)
 }
 
+// BlocksPerYear returns the number of blocks produced in a year at the given block time
+func BlocksPerYear(blockTime time.Duration) int64 {
+	if blockTime <= 0 || blockTime > year {
+		return 1
+	}
+	return int64(year / blockTime)
+}
+
 // BlockProvision gets the provisions for a block based on the annual provisions rate
-func (m Minter) BlockProvision(params Params) sdk.Coin {
+// and the given block time
+func (m Minter) BlockProvision(params Params, blockTime time.Duration) sdk.Coin {
 	provisions := m.NextAnnualProvisions(params)
-	blockInflationAmount := provisions.QuoInt(sdk.NewInt(blocksPerYear))
+	blockInflationAmount := provisions.QuoInt(sdk.NewInt(BlocksPerYear(blockTime)))
 	return sdk.NewCoin(params.MintDenom, blockInflationAmount.TruncateInt())
 }
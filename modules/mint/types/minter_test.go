@@ -18,12 +18,24 @@ func TestNextInflation(t *testing.T) {
 	}
 	for _, tc := range tests {
 		annualProvisions := minter.NextAnnualProvisions(tc.params)
-		mintCoin := minter.BlockProvision(tc.params)
+		mintCoin := minter.BlockProvision(tc.params, DefaultBlockTime)
 		blockProvision := annualProvisions.QuoInt(sdk.NewInt(12 * 60 * 8766))
 		require.True(t, mintCoin.Amount.Equal(blockProvision.TruncateInt()), "mint amount:"+mintCoin.Amount.String()+", block provision amount: "+blockProvision.TruncateInt().String())
+
+		// slower blocks mint more per block
+		mintCoin = minter.BlockProvision(tc.params, 6*time.Second)
+		blockProvision = annualProvisions.QuoInt(sdk.NewInt(10 * 60 * 8766))
+		require.True(t, mintCoin.Amount.Equal(blockProvision.TruncateInt()), "mint amount:"+mintCoin.Amount.String()+", block provision amount: "+blockProvision.TruncateInt().String())
 	}
 }
 
+func TestBlocksPerYear(t *testing.T) {
+	require.Equal(t, int64(12*60*8766), BlocksPerYear(DefaultBlockTime))
+	require.Equal(t, int64(10*60*8766), BlocksPerYear(6*time.Second))
+	require.Equal(t, int64(1), BlocksPerYear(0))
+	require.Equal(t, int64(1), BlocksPerYear(2*year))
+}
+
 func TestDefaultMinter(t *testing.T) {
 	err := ValidateMinter(DefaultMinter())
 	require.NoError(t, err)
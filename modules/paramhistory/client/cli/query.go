@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/irisnet/irishub/modules/paramhistory/types"
+)
+
+// GetQueryCmd returns the cli query commands for the paramhistory module.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the paramhistory module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdQueryChanges(),
+		GetCmdQueryParam(),
+	)
+	return queryCmd
+}
+
+// GetCmdQueryChanges implements the query parameter changes command.
+func GetCmdQueryChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "changes [subspace] [key]",
+		Short:   "Query the parameter changes of a subspace, optionally narrowed to a key",
+		Example: fmt.Sprintf("%s query paramhistory changes staking MaxValidators", version.AppName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChangesRequest{Subspace: args[0], Pagination: pageReq}
+			if len(args) == 2 {
+				req.Key = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Changes(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "changes")
+	return cmd
+}
+
+// GetCmdQueryParam implements the query parameter value command.
+func GetCmdQueryParam() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "param [subspace] [key] [height]",
+		Short:   "Query the value of a parameter at a height, the latest one by default",
+		Example: fmt.Sprintf("%s query paramhistory param staking MaxValidators 1000", version.AppName),
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryParamRequest{Subspace: args[0], Key: args[1]}
+			if len(args) == 3 {
+				if req.Height, err = strconv.ParseInt(args[2], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Param(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
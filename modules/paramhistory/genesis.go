@@ -0,0 +1,40 @@
+package paramhistory
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/paramhistory/keeper"
+	"github.com/irisnet/irishub/modules/paramhistory/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize paramhistory genesis state: %s", err.Error()))
+	}
+	for _, change := range data.Changes {
+		keeper.SetParamChange(ctx, change)
+	}
+}
+
+// ExportGenesis outputs genesis data
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var changes []types.ParamChange
+	k.IterateAllParamChanges(
+		ctx,
+		func(change types.ParamChange) bool {
+			changes = append(changes, change)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(changes)
+}
+
+// ValidateGenesis performs basic validation of paramhistory genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
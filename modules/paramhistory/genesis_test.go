@@ -0,0 +1,50 @@
+package paramhistory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/paramhistory"
+	"github.com/irisnet/irishub/modules/paramhistory/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	suite.app = app
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) TestExportGenesis() {
+	defaultGenesis := types.DefaultGenesisState()
+	exportedGenesis := paramhistory.ExportGenesis(suite.ctx, suite.app.ParamHistoryKeeper)
+	suite.Equal(defaultGenesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestInitGenesis() {
+	genesis := types.NewGenesisState([]types.ParamChange{
+		types.NewParamChange("mint", "Inflation", `"0.04"`, `"0.03"`, 5, 1),
+		types.NewParamChange("staking", "MaxValidators", "100", "120", 3, 2),
+	})
+	paramhistory.InitGenesis(suite.ctx, suite.app.ParamHistoryKeeper, *genesis)
+
+	exportedGenesis := paramhistory.ExportGenesis(suite.ctx, suite.app.ParamHistoryKeeper)
+	suite.Equal(genesis, exportedGenesis)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/paramhistory/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Changes implements the Query/Changes gRPC method
+func (k Keeper) Changes(c context.Context, req *types.QueryChangesRequest) (*types.QueryChangesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Subspace) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "subspace cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var changes []types.ParamChange
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetParamChangeSubspaceKey(req.Subspace, req.Key))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var change types.ParamChange
+		k.cdc.MustUnmarshalBinaryBare(value, &change)
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// Param implements the Query/Param gRPC method
+func (k Keeper) Param(c context.Context, req *types.QueryParamRequest) (*types.QueryParamResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Subspace) == 0 || len(req.Key) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "subspace and key cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height := req.Height
+	if height <= 0 {
+		height = ctx.BlockHeight()
+	}
+
+	value, err := k.GetParamValue(ctx, req.Subspace, req.Key, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamResponse{Value: value}, nil
+}
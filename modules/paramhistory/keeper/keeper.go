@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+
+	"github.com/irisnet/irishub/modules/paramhistory/types"
+)
+
+// Keeper of the paramhistory store
+type Keeper struct {
+	cdc          codec.Marshaler
+	storeKey     sdk.StoreKey
+	paramsKeeper paramskeeper.Keeper
+}
+
+// NewKeeper returns a paramhistory keeper
+func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, paramsKeeper paramskeeper.Keeper) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     key,
+		paramsKeeper: paramsKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// SetParamChange appends a parameter change to the history
+func (k Keeper) SetParamChange(ctx sdk.Context, change types.ParamChange) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&change)
+	store.Set(types.GetParamChangeKey(change.Subspace, change.Key, change.Height, k.nextSequence(ctx)), bz)
+}
+
+// IterateParamChanges iterates through the changes of the given subspace in
+// height order, narrowed to the given key if it is not empty
+func (k Keeper) IterateParamChanges(
+	ctx sdk.Context,
+	subspace, key string,
+	op func(change types.ParamChange) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetParamChangeSubspaceKey(subspace, key))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ParamChange
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &change)
+
+		if stop := op(change); stop {
+			break
+		}
+	}
+}
+
+// IterateAllParamChanges iterates through all recorded parameter changes
+func (k Keeper) IterateAllParamChanges(
+	ctx sdk.Context,
+	op func(change types.ParamChange) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ParamChangeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ParamChange
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &change)
+
+		if stop := op(change); stop {
+			break
+		}
+	}
+}
+
+// GetParamValue returns the raw value the given parameter had at the given height
+func (k Keeper) GetParamValue(ctx sdk.Context, subspace, key string, height int64) (string, error) {
+	ss, ok := k.paramsKeeper.GetSubspace(subspace)
+	if !ok {
+		return "", sdkerrors.Wrap(types.ErrUnknownSubspace, subspace)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetParamChangeSubspaceKey(subspace, key)
+
+	// the last change at or before the height holds the value in effect
+	iterator := store.ReverseIterator(prefix, types.GetParamChangeKey(subspace, key, height+1, 0))
+	defer iterator.Close()
+	if iterator.Valid() {
+		var change types.ParamChange
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &change)
+		return change.NewValue, nil
+	}
+
+	// otherwise the first change after the height holds the value before it
+	var value string
+	found := false
+	k.IterateParamChanges(ctx, subspace, key, func(change types.ParamChange) bool {
+		value, found = change.OldValue, true
+		return true
+	})
+	if found {
+		return value, nil
+	}
+
+	// the parameter has never been changed
+	return string(ss.GetRaw(ctx, []byte(key))), nil
+}
+
+// GetRawParam returns the current raw value of the given parameter, or an
+// empty string if the subspace or the parameter does not exist
+func (k Keeper) GetRawParam(ctx sdk.Context, subspace, key string) string {
+	ss, ok := k.paramsKeeper.GetSubspace(subspace)
+	if !ok {
+		return ""
+	}
+	return string(ss.GetRaw(ctx, []byte(key)))
+}
+
+func (k Keeper) nextSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var sequence uint64
+	if bz := store.Get(types.SequenceKey); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.SequenceKey, sdk.Uint64ToBigEndian(sequence+1))
+	return sequence
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/irisnet/irishub/modules/paramhistory/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var key = string(stakingtypes.KeyMaxValidators)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 100})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestIterateParamChanges() {
+	suite.app.ParamHistoryKeeper.SetParamChange(suite.ctx, types.NewParamChange(stakingtypes.ModuleName, key, "100", "120", 20, 2))
+	suite.app.ParamHistoryKeeper.SetParamChange(suite.ctx, types.NewParamChange(stakingtypes.ModuleName, key, "50", "100", 10, 1))
+	suite.app.ParamHistoryKeeper.SetParamChange(suite.ctx, types.NewParamChange(stakingtypes.ModuleName, "UnbondingTime", "1", "2", 15, 3))
+
+	var heights []int64
+	suite.app.ParamHistoryKeeper.IterateParamChanges(suite.ctx, stakingtypes.ModuleName, key, func(change types.ParamChange) bool {
+		heights = append(heights, change.Height)
+		return false
+	})
+	require.Equal(suite.T(), []int64{10, 20}, heights)
+
+	var count int
+	suite.app.ParamHistoryKeeper.IterateParamChanges(suite.ctx, stakingtypes.ModuleName, "", func(change types.ParamChange) bool {
+		count++
+		return false
+	})
+	require.Equal(suite.T(), 3, count)
+}
+
+func (suite *KeeperTestSuite) TestGetParamValue() {
+	current, err := suite.app.ParamHistoryKeeper.GetParamValue(suite.ctx, stakingtypes.ModuleName, key, 1)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), suite.app.ParamHistoryKeeper.GetRawParam(suite.ctx, stakingtypes.ModuleName, key), current)
+
+	suite.app.ParamHistoryKeeper.SetParamChange(suite.ctx, types.NewParamChange(stakingtypes.ModuleName, key, `"50"`, `"100"`, 10, 1))
+	suite.app.ParamHistoryKeeper.SetParamChange(suite.ctx, types.NewParamChange(stakingtypes.ModuleName, key, `"100"`, `"120"`, 20, 2))
+
+	testCases := []struct {
+		height int64
+		value  string
+	}{
+		{5, `"50"`},
+		{9, `"50"`},
+		{10, `"100"`},
+		{19, `"100"`},
+		{20, `"120"`},
+		{1000, `"120"`},
+	}
+	for _, tc := range testCases {
+		value, err := suite.app.ParamHistoryKeeper.GetParamValue(suite.ctx, stakingtypes.ModuleName, key, tc.height)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), tc.value, value, "height %d", tc.height)
+	}
+
+	_, err = suite.app.ParamHistoryKeeper.GetParamValue(suite.ctx, "unknown", key, 1)
+	require.Error(suite.T(), err)
+}
@@ -0,0 +1,50 @@
+package paramhistory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/irisnet/irishub/modules/paramhistory/keeper"
+	"github.com/irisnet/irishub/modules/paramhistory/types"
+)
+
+// NewParamChangeProposalHandler wraps the given ParamChangeProposal handler to
+// record every parameter it changes in the history
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		p, ok := content.(*proposal.ParameterChangeProposal)
+		if !ok {
+			return handler(ctx, content)
+		}
+
+		// a parameter changed several times by the same proposal is recorded once
+		var changes []types.ParamChange
+		seen := make(map[string]bool)
+		for _, c := range p.Changes {
+			if seen[c.Subspace+"/"+c.Key] {
+				continue
+			}
+			seen[c.Subspace+"/"+c.Key] = true
+			changes = append(changes, types.ParamChange{
+				Subspace: c.Subspace,
+				Key:      c.Key,
+				OldValue: k.GetRawParam(ctx, c.Subspace, c.Key),
+			})
+		}
+
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+
+		proposalID := types.GetExecutingProposalID(ctx)
+		for _, change := range changes {
+			k.SetParamChange(ctx, types.NewParamChange(
+				change.Subspace, change.Key, change.OldValue,
+				k.GetRawParam(ctx, change.Subspace, change.Key),
+				ctx.BlockHeight(), proposalID,
+			))
+		}
+		return nil
+	}
+}
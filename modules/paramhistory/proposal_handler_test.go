@@ -0,0 +1,109 @@
+package paramhistory_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/modules/paramhistory/types"
+)
+
+func (suite *TestSuite) TestParamChangeProposalHandler() {
+	key := string(stakingtypes.KeyMaxValidators)
+	oldValue := suite.app.ParamHistoryKeeper.GetRawParam(suite.ctx, stakingtypes.ModuleName, key)
+
+	content := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		proposal.NewParamChange(stakingtypes.ModuleName, key, `200`),
+	})
+
+	submitted, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+	suite.NoError(err)
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, submitted)
+	submitted, _ = suite.app.GovKeeper.GetProposal(suite.ctx, submitted.ProposalId)
+	ctx := suite.ctx.WithBlockTime(submitted.VotingEndTime)
+
+	handler := suite.app.GovKeeper.Router().GetRoute(proposal.RouterKey)
+	suite.NoError(handler(types.WithExecutingProposalID(ctx, submitted.ProposalId), content))
+
+	var changes []types.ParamChange
+	suite.app.ParamHistoryKeeper.IterateAllParamChanges(ctx, func(change types.ParamChange) bool {
+		changes = append(changes, change)
+		return false
+	})
+	suite.Equal(
+		[]types.ParamChange{types.NewParamChange(stakingtypes.ModuleName, key, oldValue, `200`, ctx.BlockHeight(), submitted.ProposalId)},
+		changes,
+	)
+	suite.Equal(`200`, suite.app.ParamHistoryKeeper.GetRawParam(ctx, stakingtypes.ModuleName, key))
+}
+
+func (suite *TestSuite) TestParamChangeProposalHandlerFailed() {
+	content := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+		proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), `"invalid"`),
+	})
+
+	handler := suite.app.GovKeeper.Router().GetRoute(proposal.RouterKey)
+	suite.Error(handler(suite.ctx, content))
+
+	count := 0
+	suite.app.ParamHistoryKeeper.IterateAllParamChanges(suite.ctx, func(change types.ParamChange) bool {
+		count++
+		return false
+	})
+	suite.Zero(count)
+}
+
+func (suite *TestSuite) TestIdenticalProposalsInOneBlock() {
+	validator := sdk.ValAddress([]byte("paramhistory-validat"))
+	selfDelegation := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.NewCoins(selfDelegation)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, sdk.AccAddress(validator), sdk.NewCoins(selfDelegation)))
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		validator, ed25519.GenPrivKey().PubKey(), selfDelegation, stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	suite.Require().NoError(err)
+	_, err = stakingkeeper.NewMsgServerImpl(suite.app.StakingKeeper).CreateValidator(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	staking.EndBlocker(suite.ctx, suite.app.StakingKeeper)
+
+	key := string(stakingtypes.KeyMaxValidators)
+	oldValue := suite.app.ParamHistoryKeeper.GetRawParam(suite.ctx, stakingtypes.ModuleName, key)
+
+	// both proposals carry the same content and pass in the same block
+	var proposals []govtypes.Proposal
+	for i := 0; i < 2; i++ {
+		content := proposal.NewParameterChangeProposal("title", "description", []proposal.ParamChange{
+			proposal.NewParamChange(stakingtypes.ModuleName, key, `200`),
+		})
+		submitted, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+		suite.Require().NoError(err)
+		suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, submitted)
+		suite.Require().NoError(suite.app.GovKeeper.AddVote(suite.ctx, submitted.ProposalId, sdk.AccAddress(validator), govtypes.OptionYes))
+		submitted, _ = suite.app.GovKeeper.GetProposal(suite.ctx, submitted.ProposalId)
+		proposals = append(proposals, submitted)
+	}
+
+	ctx := suite.ctx.WithBlockTime(proposals[1].VotingEndTime)
+	suite.app.EndBlocker(ctx, abci.RequestEndBlock{})
+
+	var changes []types.ParamChange
+	suite.app.ParamHistoryKeeper.IterateAllParamChanges(ctx, func(change types.ParamChange) bool {
+		changes = append(changes, change)
+		return false
+	})
+	suite.Equal(
+		[]types.ParamChange{
+			types.NewParamChange(stakingtypes.ModuleName, key, oldValue, `200`, ctx.BlockHeight(), proposals[0].ProposalId),
+			types.NewParamChange(stakingtypes.ModuleName, key, `200`, `200`, ctx.BlockHeight(), proposals[1].ProposalId),
+		},
+		changes,
+	)
+}
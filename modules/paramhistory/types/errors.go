@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// paramhistory module sentinel errors
+var (
+	ErrInvalidParamChange = sdkerrors.Register(ModuleName, 2, "invalid parameter change")
+	ErrUnknownSubspace    = sdkerrors.Register(ModuleName, 3, "unknown subspace")
+)
@@ -0,0 +1,23 @@
+package types
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(changes []ParamChange) *GenesisState {
+	return &GenesisState{
+		Changes: changes,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil)
+}
+
+// ValidateGenesis validates the provided paramhistory genesis state
+func ValidateGenesis(data GenesisState) error {
+	for _, change := range data.Changes {
+		if err := change.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "paramhistory"
+
+	// StoreKey is the default store key for paramhistory
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the paramhistory store
+	QuerierRoute = StoreKey
+
+	// Query endpoints supported by the paramhistory querier
+	QueryChanges = "changes"
+	QueryParam   = "param"
+)
+
+var (
+	ParamChangeKey = []byte{0x01} // prefix for the recorded parameter changes
+	SequenceKey    = []byte{0x02} // key for the sequence of the recorded parameter changes
+)
+
+// GetParamChangeSubspaceKey returns the key prefix of all changes of the given
+// subspace, narrowed to the given key if it is not empty
+func GetParamChangeSubspaceKey(subspace, key string) []byte {
+	prefix := append(ParamChangeKey, lengthPrefix(subspace)...)
+	if len(key) == 0 {
+		return prefix
+	}
+	return append(prefix, lengthPrefix(key)...)
+}
+
+// GetParamChangeKey returns the key of a parameter change. Changes of the
+// same parameter are ordered by height, then by the order they were recorded.
+func GetParamChangeKey(subspace, key string, height int64, sequence uint64) []byte {
+	return append(
+		append(GetParamChangeSubspaceKey(subspace, key), sdk.Uint64ToBigEndian(uint64(height))...),
+		sdk.Uint64ToBigEndian(sequence)...,
+	)
+}
+
+func lengthPrefix(s string) []byte {
+	return append([]byte{byte(len(s))}, s...)
+}
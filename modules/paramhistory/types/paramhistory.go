@@ -0,0 +1,59 @@
+package types
+
+import (
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxKeyLength is the maximum length of a subspace name or parameter key,
+// bounded by the single byte length prefix of the store keys
+const MaxKeyLength = 255
+
+type executingProposalKey struct{}
+
+// WithExecutingProposalID returns a context in which the content of the given
+// proposal is executed, as set by the gov end blocker of the app
+func WithExecutingProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(executingProposalKey{}, proposalID)
+}
+
+// GetExecutingProposalID returns the ID of the proposal whose content is
+// executed in the context, or 0 if none is
+func GetExecutingProposalID(ctx sdk.Context) uint64 {
+	proposalID, _ := ctx.Value(executingProposalKey{}).(uint64)
+	return proposalID
+}
+
+// NewParamChange constructs a ParamChange
+func NewParamChange(subspace, key, oldValue, newValue string, height int64, proposalID uint64) ParamChange {
+	return ParamChange{
+		Subspace:   subspace,
+		Key:        key,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+		Height:     height,
+		ProposalId: proposalID,
+	}
+}
+
+// String implements fmt.Stringer
+func (c ParamChange) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// Validate validates the parameter change
+func (c ParamChange) Validate() error {
+	if len(c.Subspace) == 0 || len(c.Subspace) > MaxKeyLength {
+		return sdkerrors.Wrapf(ErrInvalidParamChange, "invalid subspace length %d", len(c.Subspace))
+	}
+	if len(c.Key) == 0 || len(c.Key) > MaxKeyLength {
+		return sdkerrors.Wrapf(ErrInvalidParamChange, "invalid key length %d", len(c.Key))
+	}
+	if c.Height < 0 {
+		return sdkerrors.Wrapf(ErrInvalidParamChange, "invalid height %d", c.Height)
+	}
+	return nil
+}
@@ -0,0 +1,548 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: paramhistory/paramhistory.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ParamChange defines a parameter change applied by a governance proposal
+type ParamChange struct {
+	Subspace   string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key        string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	OldValue   string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty" yaml:"old_value"`
+	NewValue   string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty" yaml:"new_value"`
+	Height     int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	ProposalId uint64 `protobuf:"varint,6,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+}
+
+func (m *ParamChange) Reset()      { *m = ParamChange{} }
+func (*ParamChange) ProtoMessage() {}
+func (*ParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e3c9a5dd7a4ddb3, []int{0}
+}
+func (m *ParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChange.Merge(m, src)
+}
+func (m *ParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChange proto.InternalMessageInfo
+
+func (m *ParamChange) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *ParamChange) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *ParamChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+func (m *ParamChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParamChange) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ParamChange)(nil), "irishub.paramhistory.ParamChange")
+}
+
+func init() { proto.RegisterFile("paramhistory/paramhistory.proto", fileDescriptor_5e3c9a5dd7a4ddb3) }
+
+var fileDescriptor_5e3c9a5dd7a4ddb3 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x6e, 0xf2, 0x30,
+	0x1c, 0xc4, 0xe3, 0x0f, 0x3e, 0x04, 0x66, 0x41, 0x16, 0x42, 0x11, 0x83, 0x83, 0x32, 0x31, 0x61,
+	0x55, 0x55, 0x55, 0x89, 0x91, 0x4e, 0x9d, 0x5a, 0x65, 0xe8, 0xd0, 0x05, 0x19, 0x62, 0x25, 0x56,
+	0x9d, 0xd8, 0x8a, 0x9d, 0xa2, 0xbc, 0x45, 0xc7, 0x8e, 0x7d, 0x9c, 0x8e, 0x8c, 0x9d, 0x50, 0x05,
+	0x6f, 0xc0, 0xd4, 0xb1, 0x72, 0x92, 0x46, 0x69, 0xb7, 0xbb, 0xff, 0xdd, 0xfd, 0x87, 0x1f, 0xf4,
+	0x14, 0xcd, 0x68, 0x12, 0x73, 0x6d, 0x64, 0x56, 0x90, 0xb6, 0x59, 0xa8, 0x4c, 0x1a, 0x89, 0xc6,
+	0x3c, 0xe3, 0x3a, 0xce, 0x37, 0x8b, 0x76, 0x36, 0x1d, 0x47, 0x32, 0x92, 0x65, 0x81, 0x58, 0x55,
+	0x75, 0xfd, 0x2f, 0x00, 0x87, 0xf7, 0xb6, 0x76, 0x13, 0xd3, 0x34, 0x62, 0x68, 0x0a, 0xfb, 0x3a,
+	0xdf, 0x68, 0x45, 0xb7, 0xcc, 0x05, 0x33, 0x30, 0x1f, 0x04, 0x8d, 0x47, 0x23, 0xd8, 0x79, 0x62,
+	0x85, 0xfb, 0xaf, 0x3c, 0x5b, 0x89, 0x2e, 0xe0, 0x40, 0x8a, 0x70, 0xfd, 0x4c, 0x45, 0xce, 0xdc,
+	0x8e, 0xbd, 0xaf, 0xc6, 0xe7, 0x83, 0x37, 0x2a, 0x68, 0x22, 0x96, 0x7e, 0x13, 0xf9, 0x41, 0x5f,
+	0x8a, 0xf0, 0xc1, 0x4a, 0x3b, 0x49, 0xd9, 0xae, 0x9e, 0x74, 0xff, 0x4e, 0x9a, 0xc8, 0x0f, 0xfa,
+	0x29, 0xdb, 0x55, 0x93, 0x09, 0xec, 0xc5, 0x8c, 0x47, 0xb1, 0x71, 0xff, 0xcf, 0xc0, 0xbc, 0x13,
+	0xd4, 0x0e, 0x5d, 0xc3, 0xa1, 0xca, 0xa4, 0x92, 0x9a, 0x8a, 0x35, 0x0f, 0xdd, 0xde, 0x0c, 0xcc,
+	0xbb, 0xab, 0xc9, 0xf9, 0xe0, 0xa1, 0xea, 0x59, 0x2b, 0xf4, 0x03, 0xf8, 0xe3, 0x6e, 0xc3, 0x65,
+	0xf7, 0xf5, 0xcd, 0x73, 0x56, 0x77, 0xef, 0x47, 0x0c, 0xf6, 0x47, 0x0c, 0x3e, 0x8f, 0x18, 0xbc,
+	0x9c, 0xb0, 0xb3, 0x3f, 0x61, 0xe7, 0xe3, 0x84, 0x9d, 0xc7, 0xab, 0x88, 0x1b, 0xcb, 0x6f, 0x2b,
+	0x13, 0x62, 0x59, 0xa6, 0xcc, 0x90, 0x9a, 0x29, 0x49, 0x64, 0x98, 0x0b, 0xa6, 0x7f, 0x71, 0x27,
+	0xa6, 0x50, 0x4c, 0x6f, 0x7a, 0x25, 0xd2, 0xcb, 0xef, 0x01, 0x00, 0xec, 0xe4, 0x7d, 0x94, 0xa1,
+	0x01, 0x00, 0x00,
+}
+
+func (m *ParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintParamhistory(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintParamhistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintParamhistory(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintParamhistory(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintParamhistory(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintParamhistory(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParamhistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParamhistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovParamhistory(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovParamhistory(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovParamhistory(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovParamhistory(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovParamhistory(uint64(m.Height))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovParamhistory(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func sovParamhistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParamhistory(x uint64) (n int) {
+	return sovParamhistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParamhistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamhistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamhistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamhistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamhistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamhistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamhistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamhistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamhistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamhistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParamhistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParamhistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParamhistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParamhistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParamhistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParamhistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParamhistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParamhistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParamhistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParamhistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParamhistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParamhistory = fmt.Errorf("proto: unexpected end of group")
+)
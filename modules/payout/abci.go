@@ -0,0 +1,27 @@
+package payout
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/payout/keeper"
+	"github.com/irisnet/irishub/modules/payout/types"
+)
+
+// BeginBlocker ends the current epoch once it has lasted the epoch length,
+// the reports of the ended epoch are final from then on
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	ended, advanced := k.AdvanceEpoch(ctx)
+	if !advanced {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(ended.Number, 10)),
+			sdk.NewAttribute(types.AttributeKeyNextEpoch, strconv.FormatUint(ended.Number+1, 10)),
+		),
+	)
+}
@@ -0,0 +1,40 @@
+package payout
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/payout/keeper"
+	"github.com/irisnet/irishub/modules/payout/types"
+)
+
+// InitGenesis stores genesis data
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := ValidateGenesis(data); err != nil {
+		panic(fmt.Errorf("failed to initialize payout genesis state: %s", err.Error()))
+	}
+
+	k.SetParamSet(ctx, data.Params)
+	k.SetEpoch(ctx, data.Epoch)
+	for _, report := range data.Reports {
+		k.SetReport(ctx, report)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var reports []types.ProviderReport
+	k.IterateReports(ctx, func(report types.ProviderReport) bool {
+		reports = append(reports, report)
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParamSet(ctx), k.GetEpoch(ctx), reports)
+}
+
+// ValidateGenesis performs basic validation of payout genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
@@ -0,0 +1,76 @@
+package payout_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/payout"
+	"github.com/irisnet/irishub/modules/payout/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) TestInitGenesis() {
+	provider := sdk.AccAddress(tmhash.SumTruncated([]byte("provider")))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	report := types.NewProviderReport(provider, 2)
+	report.Responses = 1
+	report.EarnedFees = fee
+
+	genesis := types.NewGenesisState(types.NewParams(100), types.NewEpoch(3, 200), []types.ProviderReport{report})
+	payout.InitGenesis(suite.ctx, suite.app.PayoutKeeper, *genesis)
+
+	exportedGenesis := payout.ExportGenesis(suite.ctx, suite.app.PayoutKeeper)
+	suite.Equal(genesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestValidateGenesis() {
+	provider := sdk.AccAddress(tmhash.SumTruncated([]byte("provider")))
+	report := types.NewProviderReport(provider, 1)
+
+	testCases := []struct {
+		name    string
+		genesis *types.GenesisState
+		expPass bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"with report", types.NewGenesisState(types.DefaultParams(), types.DefaultEpoch(), []types.ProviderReport{report}), true},
+		{"zero epoch length", types.NewGenesisState(types.NewParams(0), types.DefaultEpoch(), nil), false},
+		{"zero epoch", types.NewGenesisState(types.DefaultParams(), types.NewEpoch(0, 0), nil), false},
+		{"report of a future epoch", types.NewGenesisState(types.DefaultParams(), types.DefaultEpoch(), []types.ProviderReport{types.NewProviderReport(provider, 2)}), false},
+		{"duplicate report", types.NewGenesisState(types.DefaultParams(), types.DefaultEpoch(), []types.ProviderReport{report, report}), false},
+	}
+
+	for _, tc := range testCases {
+		err := payout.ValidateGenesis(*tc.genesis)
+		if tc.expPass {
+			suite.NoError(err, tc.name)
+		} else {
+			suite.Error(err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/payout/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the payout parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Epoch queries the current payout epoch
+func (k Keeper) Epoch(c context.Context, _ *types.QueryEpochRequest) (*types.QueryEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEpochResponse{Epoch: k.GetEpoch(ctx)}, nil
+}
+
+// Report queries the report of a provider for an epoch
+func (k Keeper) Report(c context.Context, req *types.QueryReportRequest) (*types.QueryReportResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	provider, err := sdk.AccAddressFromBech32(req.Provider)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	report, found := k.GetReport(ctx, provider, req.Epoch)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownReport, "provider %s, epoch %d", req.Provider, req.Epoch)
+	}
+
+	return &types.QueryReportResponse{Report: report}, nil
+}
+
+// Reports queries the reports of a provider in ascending epoch order
+func (k Keeper) Reports(c context.Context, req *types.QueryReportsRequest) (*types.QueryReportsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	provider, err := sdk.AccAddressFromBech32(req.Provider)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var reports []types.ProviderReport
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetProviderReportsKey(provider))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var report types.ProviderReport
+		k.cdc.MustUnmarshalBinaryBare(value, &report)
+		reports = append(reports, report)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryReportsResponse{Reports: reports, Pagination: pageRes}, nil
+}
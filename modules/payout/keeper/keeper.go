@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/payout/types"
+)
+
+// Keeper of the payout store
+type Keeper struct {
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper returns a payout keeper
+func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GetParamSet returns payout params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var p types.Params
+	k.paramSpace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParamSet sets payout params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetEpoch returns the current epoch
+func (k Keeper) GetEpoch(ctx sdk.Context) (epoch types.Epoch) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EpochKey)
+	if bz == nil {
+		return types.DefaultEpoch()
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &epoch)
+	return epoch
+}
+
+// SetEpoch stores the current epoch
+func (k Keeper) SetEpoch(ctx sdk.Context, epoch types.Epoch) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&epoch)
+	store.Set(types.EpochKey, bz)
+}
+
+// AdvanceEpoch starts the next epoch if the current one has lasted the
+// epoch length, returning the epoch which ended, if any. A shortened epoch
+// length ends an epoch which already lasted longer at the next block.
+func (k Keeper) AdvanceEpoch(ctx sdk.Context) (ended types.Epoch, advanced bool) {
+	epoch := k.GetEpoch(ctx)
+	length := k.GetParamSet(ctx).EpochLength
+
+	if ctx.BlockHeight()-epoch.StartHeight < int64(length) {
+		return ended, false
+	}
+
+	k.SetEpoch(ctx, types.NewEpoch(epoch.Number+1, ctx.BlockHeight()))
+	return epoch, true
+}
+
+// RecordResponse adds a response and the fee earned for it to the report of
+// the provider for the current epoch
+func (k Keeper) RecordResponse(ctx sdk.Context, provider sdk.AccAddress, earnedFee, tax sdk.Coins) {
+	report := k.getCurrentReport(ctx, provider)
+	report.Responses++
+	report.EarnedFees = report.EarnedFees.Add(earnedFee...)
+	report.TaxWithheld = report.TaxWithheld.Add(tax...)
+	k.SetReport(ctx, report)
+}
+
+// RecordSlash adds a slash to the report of the provider for the current epoch
+func (k Keeper) RecordSlash(ctx sdk.Context, provider sdk.AccAddress, slashed sdk.Coins) {
+	report := k.getCurrentReport(ctx, provider)
+	report.Slashes++
+	report.Slashed = report.Slashed.Add(slashed...)
+	k.SetReport(ctx, report)
+}
+
+func (k Keeper) getCurrentReport(ctx sdk.Context, provider sdk.AccAddress) types.ProviderReport {
+	epoch := k.GetEpoch(ctx).Number
+
+	report, found := k.GetReport(ctx, provider, epoch)
+	if !found {
+		return types.NewProviderReport(provider, epoch)
+	}
+	return report
+}
+
+// GetReport returns the report of a provider for an epoch
+func (k Keeper) GetReport(ctx sdk.Context, provider sdk.AccAddress, epoch uint64) (report types.ProviderReport, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetReportKey(provider, epoch))
+	if bz == nil {
+		return report, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &report)
+	return report, true
+}
+
+// SetReport stores the report of a provider for an epoch
+func (k Keeper) SetReport(ctx sdk.Context, report types.ProviderReport) {
+	provider, err := sdk.AccAddressFromBech32(report.Provider)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&report)
+	store.Set(types.GetReportKey(provider, report.Epoch), bz)
+}
+
+// IterateReports iterates through the reports of all providers
+func (k Keeper) IterateReports(ctx sdk.Context, op func(report types.ProviderReport) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ReportKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var report types.ProviderReport
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &report)
+
+		if stop := op(report); stop {
+			break
+		}
+	}
+}
+
+// GetProviderReports returns the reports of a provider in ascending epoch order
+func (k Keeper) GetProviderReports(ctx sdk.Context, provider sdk.AccAddress) []types.ProviderReport {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetProviderReportsKey(provider))
+	defer iterator.Close()
+
+	var reports []types.ProviderReport
+	for ; iterator.Valid(); iterator.Next() {
+		var report types.ProviderReport
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &report)
+		reports = append(reports, report)
+	}
+	return reports
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irisnet/irishub/modules/payout/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	provider  = sdk.AccAddress(tmhash.SumTruncated([]byte("provider")))
+	provider2 = sdk.AccAddress(tmhash.SumTruncated([]byte("provider2")))
+
+	testFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 95))
+	testTax = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *simapp.SimApp
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	suite.app = app
+
+	suite.app.PayoutKeeper.SetParamSet(suite.ctx, types.NewParams(10))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.PayoutKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestSetGetParamSet() {
+	params := types.DefaultParams()
+	suite.app.PayoutKeeper.SetParamSet(suite.ctx, params)
+	suite.Equal(params, suite.app.PayoutKeeper.GetParamSet(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestAdvanceEpoch() {
+	k := suite.app.PayoutKeeper
+	suite.Equal(types.DefaultEpoch(), k.GetEpoch(suite.ctx))
+
+	_, advanced := k.AdvanceEpoch(suite.ctx.WithBlockHeight(9))
+	suite.False(advanced)
+
+	ended, advanced := k.AdvanceEpoch(suite.ctx.WithBlockHeight(10))
+	suite.True(advanced)
+	suite.Equal(types.DefaultEpoch(), ended)
+	suite.Equal(types.NewEpoch(2, 10), k.GetEpoch(suite.ctx))
+
+	_, advanced = k.AdvanceEpoch(suite.ctx.WithBlockHeight(19))
+	suite.False(advanced)
+
+	// a shortened epoch length ends the current epoch at the next block
+	k.SetParamSet(suite.ctx, types.NewParams(5))
+	ended, advanced = k.AdvanceEpoch(suite.ctx.WithBlockHeight(19))
+	suite.True(advanced)
+	suite.Equal(types.NewEpoch(2, 10), ended)
+	suite.Equal(types.NewEpoch(3, 19), k.GetEpoch(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestRecordReports() {
+	k := suite.app.PayoutKeeper
+
+	k.RecordResponse(suite.ctx, provider, testFee, testTax)
+	k.RecordResponse(suite.ctx, provider, testFee, testTax)
+	k.RecordSlash(suite.ctx, provider, testTax)
+
+	report, found := k.GetReport(suite.ctx, provider, 1)
+	suite.True(found)
+	suite.Equal(provider.String(), report.Provider)
+	suite.Equal(uint64(1), report.Epoch)
+	suite.Equal(uint64(2), report.Responses)
+	suite.Equal(testFee.Add(testFee...), report.EarnedFees)
+	suite.Equal(testTax.Add(testTax...), report.TaxWithheld)
+	suite.Equal(uint64(1), report.Slashes)
+	suite.Equal(testTax, report.Slashed)
+
+	_, found = k.GetReport(suite.ctx, provider2, 1)
+	suite.False(found)
+
+	// the records of the next epoch go to a new report
+	k.AdvanceEpoch(suite.ctx.WithBlockHeight(10))
+	k.RecordSlash(suite.ctx, provider, testTax)
+
+	report, found = k.GetReport(suite.ctx, provider, 2)
+	suite.True(found)
+	suite.Equal(uint64(0), report.Responses)
+	suite.Equal(uint64(1), report.Slashes)
+
+	reports := k.GetProviderReports(suite.ctx, provider)
+	suite.Len(reports, 2)
+	suite.Equal(uint64(1), reports[0].Epoch)
+	suite.Equal(uint64(2), reports[1].Epoch)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryReports() {
+	k := suite.app.PayoutKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	for height := int64(10); height <= 30; height += 10 {
+		k.RecordResponse(suite.ctx, provider, testFee, testTax)
+		k.AdvanceEpoch(suite.ctx.WithBlockHeight(height))
+	}
+	k.RecordResponse(suite.ctx, provider2, testFee, testTax)
+
+	epochRes, err := suite.queryClient.Epoch(ctx, &types.QueryEpochRequest{})
+	suite.NoError(err)
+	suite.Equal(types.NewEpoch(4, 30), epochRes.Epoch)
+
+	reportRes, err := suite.queryClient.Report(ctx, &types.QueryReportRequest{Provider: provider.String(), Epoch: 2})
+	suite.NoError(err)
+	suite.Equal(uint64(2), reportRes.Report.Epoch)
+	suite.Equal(uint64(1), reportRes.Report.Responses)
+
+	_, err = suite.queryClient.Report(ctx, &types.QueryReportRequest{Provider: provider.String(), Epoch: 4})
+	suite.Error(err)
+
+	reportsRes, err := suite.queryClient.Reports(ctx, &types.QueryReportsRequest{
+		Provider:   provider.String(),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(reportsRes.Reports, 2)
+	suite.Equal(uint64(3), reportsRes.Pagination.Total)
+	suite.Equal(uint64(1), reportsRes.Reports[0].Epoch)
+
+	reportsRes, err = suite.queryClient.Reports(ctx, &types.QueryReportsRequest{Provider: provider2.String()})
+	suite.NoError(err)
+	suite.Len(reportsRes.Reports, 1)
+	suite.Equal(uint64(4), reportsRes.Reports[0].Epoch)
+}
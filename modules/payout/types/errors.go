@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// payout module sentinel errors
+var (
+	ErrUnknownReport = sdkerrors.Register(ModuleName, 2, "unknown provider report")
+)
@@ -0,0 +1,9 @@
+package types
+
+// payout module event types and attributes
+const (
+	EventTypeEpochEnd = "payout_epoch_end"
+
+	AttributeKeyEpoch     = "epoch"
+	AttributeKeyNextEpoch = "next_epoch"
+)
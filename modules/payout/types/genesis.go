@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState constructs a GenesisState
+func NewGenesisState(params Params, epoch Epoch, reports []ProviderReport) *GenesisState {
+	return &GenesisState{
+		Params:  params,
+		Epoch:   epoch,
+		Reports: reports,
+	}
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Epoch:  DefaultEpoch(),
+	}
+}
+
+// ValidateGenesis validates the provided payout genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.Epoch.Number == 0 {
+		return fmt.Errorf("payout epoch number must be positive")
+	}
+	if data.Epoch.StartHeight < 0 {
+		return fmt.Errorf("payout epoch start height must not be negative, got %d", data.Epoch.StartHeight)
+	}
+
+	reports := make(map[string]bool, len(data.Reports))
+	for _, report := range data.Reports {
+		if _, err := sdk.AccAddressFromBech32(report.Provider); err != nil {
+			return err
+		}
+		if report.Epoch == 0 || report.Epoch > data.Epoch.Number {
+			return fmt.Errorf("invalid epoch %d of the report of [%s]", report.Epoch, report.Provider)
+		}
+		for _, coins := range []sdk.Coins{report.EarnedFees, report.TaxWithheld, report.Slashed} {
+			if !coins.IsValid() {
+				return fmt.Errorf("invalid coins [%s] in the report of [%s] for epoch %d", coins, report.Provider, report.Epoch)
+			}
+		}
+
+		key := fmt.Sprintf("%s/%d", report.Provider, report.Epoch)
+		if reports[key] {
+			return fmt.Errorf("duplicate report of [%s] for epoch %d", report.Provider, report.Epoch)
+		}
+		reports[key] = true
+	}
+
+	return nil
+}
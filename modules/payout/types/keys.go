@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "payout"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the payout querier
+	QueryParameters = "parameters"
+	QueryEpoch      = "epoch"
+	QueryReport     = "report"
+	QueryReports    = "reports"
+)
+
+var (
+	// Keys for store prefixes
+	ReportKey = []byte{0x01} // prefix for the provider reports
+	EpochKey  = []byte{0x02} // key for the current epoch
+)
+
+// GetProviderReportsKey returns the key prefix of all reports of the given provider
+func GetProviderReportsKey(provider sdk.AccAddress) []byte {
+	return append(ReportKey, append([]byte{byte(len(provider))}, provider.Bytes()...)...)
+}
+
+// GetReportKey returns the key of the report of a provider for an epoch.
+// The reports of a provider are ordered by epoch.
+func GetReportKey(provider sdk.AccAddress, epoch uint64) []byte {
+	return append(GetProviderReportsKey(provider), sdk.Uint64ToBigEndian(epoch)...)
+}
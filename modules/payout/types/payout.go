@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEpoch constructs an Epoch
+func NewEpoch(number uint64, startHeight int64) Epoch {
+	return Epoch{
+		Number:      number,
+		StartHeight: startHeight,
+	}
+}
+
+// DefaultEpoch returns the first epoch, which starts with the chain
+func DefaultEpoch() Epoch {
+	return NewEpoch(1, 0)
+}
+
+// NewProviderReport constructs an empty ProviderReport
+func NewProviderReport(provider sdk.AccAddress, epoch uint64) ProviderReport {
+	return ProviderReport{
+		Provider: provider.String(),
+		Epoch:    epoch,
+	}
+}
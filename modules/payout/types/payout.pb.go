@@ -0,0 +1,980 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: payout/payout.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines payout module's parameters
+type Params struct {
+	// number of blocks in an epoch
+	EpochLength uint64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty" yaml:"epoch_length"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09108cb199fa0c97, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+// Epoch defines the current payout epoch
+type Epoch struct {
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// height of the first block of the epoch
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+}
+
+func (m *Epoch) Reset()         { *m = Epoch{} }
+func (m *Epoch) String() string { return proto.CompactTextString(m) }
+func (*Epoch) ProtoMessage()    {}
+func (*Epoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09108cb199fa0c97, []int{1}
+}
+func (m *Epoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Epoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Epoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Epoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Epoch.Merge(m, src)
+}
+func (m *Epoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *Epoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_Epoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Epoch proto.InternalMessageInfo
+
+func (m *Epoch) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Epoch) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// ProviderReport defines the fees earned and the slashes incurred by a service provider in an epoch
+type ProviderReport struct {
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Epoch    uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// number of responses sent
+	Responses uint64 `protobuf:"varint,3,opt,name=responses,proto3" json:"responses,omitempty"`
+	// service fees earned, after tax
+	EarnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=earned_fees,json=earnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earned_fees" yaml:"earned_fees"`
+	// tax withheld from the service fees
+	TaxWithheld github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=tax_withheld,json=taxWithheld,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tax_withheld" yaml:"tax_withheld"`
+	// number of expired requests the provider was slashed for
+	Slashes uint64 `protobuf:"varint,6,opt,name=slashes,proto3" json:"slashes,omitempty"`
+	// deposit slashed
+	Slashed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=slashed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"slashed"`
+}
+
+func (m *ProviderReport) Reset()         { *m = ProviderReport{} }
+func (m *ProviderReport) String() string { return proto.CompactTextString(m) }
+func (*ProviderReport) ProtoMessage()    {}
+func (*ProviderReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09108cb199fa0c97, []int{2}
+}
+func (m *ProviderReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderReport.Merge(m, src)
+}
+func (m *ProviderReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderReport proto.InternalMessageInfo
+
+func (m *ProviderReport) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderReport) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ProviderReport) GetResponses() uint64 {
+	if m != nil {
+		return m.Responses
+	}
+	return 0
+}
+
+func (m *ProviderReport) GetEarnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EarnedFees
+	}
+	return nil
+}
+
+func (m *ProviderReport) GetTaxWithheld() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TaxWithheld
+	}
+	return nil
+}
+
+func (m *ProviderReport) GetSlashes() uint64 {
+	if m != nil {
+		return m.Slashes
+	}
+	return 0
+}
+
+func (m *ProviderReport) GetSlashed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Slashed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "irishub.payout.Params")
+	proto.RegisterType((*Epoch)(nil), "irishub.payout.Epoch")
+	proto.RegisterType((*ProviderReport)(nil), "irishub.payout.ProviderReport")
+}
+
+func init() { proto.RegisterFile("payout/payout.proto", fileDescriptor_09108cb199fa0c97) }
+
+var fileDescriptor_09108cb199fa0c97 = []byte{
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0xc9, 0x8f, 0xd2, 0x73, 0xd5, 0xe1, 0x5a, 0x81, 0x89, 0x90, 0x1d, 0x79, 0xca, 0x82,
+	0x8f, 0xc2, 0x96, 0x31, 0x88, 0xf2, 0x43, 0x0c, 0x95, 0x17, 0x24, 0x18, 0xa2, 0x73, 0xfc, 0xb0,
+	0x2d, 0x6c, 0x9f, 0x75, 0x77, 0x2e, 0xcd, 0x8a, 0xc4, 0xce, 0xc8, 0xc8, 0xcc, 0x5f, 0xd2, 0xb1,
+	0x23, 0x53, 0x40, 0xc9, 0xce, 0xd0, 0xbf, 0x00, 0xf9, 0xee, 0xd2, 0x7a, 0xab, 0x32, 0xdd, 0x7d,
+	0xdf, 0xf3, 0xfb, 0xde, 0xa7, 0xef, 0xfc, 0xd0, 0x51, 0x4d, 0x97, 0xac, 0x91, 0x44, 0x1f, 0x61,
+	0xcd, 0x99, 0x64, 0xf8, 0x30, 0xe7, 0xb9, 0xc8, 0x9a, 0x38, 0xd4, 0xec, 0xc8, 0x5b, 0x30, 0x51,
+	0x32, 0x41, 0x62, 0x2a, 0x80, 0x9c, 0x9f, 0xc4, 0x20, 0xe9, 0x09, 0x59, 0xb0, 0xbc, 0xd2, 0xdf,
+	0x8f, 0x8e, 0x53, 0x96, 0x32, 0x75, 0x25, 0xed, 0x4d, 0xb3, 0xc1, 0x5b, 0x34, 0x3c, 0xa3, 0x9c,
+	0x96, 0x02, 0x4f, 0xd1, 0x01, 0xd4, 0x6c, 0x91, 0xcd, 0x0b, 0xa8, 0x52, 0x99, 0xb9, 0xf6, 0xd8,
+	0x9e, 0xf4, 0x67, 0x0f, 0xaf, 0x57, 0xfe, 0xd1, 0x92, 0x96, 0xc5, 0x34, 0xe8, 0x56, 0x83, 0xc8,
+	0x51, 0xf0, 0x9d, 0x42, 0xd3, 0xfe, 0x8f, 0x9f, 0xbe, 0x15, 0x7c, 0x44, 0x83, 0x97, 0x2d, 0x89,
+	0x1f, 0xa0, 0x61, 0xd5, 0x94, 0x31, 0x70, 0x2d, 0x12, 0x19, 0xd4, 0x8e, 0x10, 0x92, 0x72, 0x39,
+	0xcf, 0x20, 0x4f, 0x33, 0xe9, 0xde, 0x1b, 0xdb, 0x93, 0x5e, 0x77, 0x44, 0xb7, 0x1a, 0x44, 0x8e,
+	0x82, 0xaf, 0x35, 0xfa, 0xd7, 0x43, 0x87, 0x67, 0x9c, 0x9d, 0xe7, 0x09, 0xf0, 0x08, 0x6a, 0xc6,
+	0x25, 0x1e, 0xa1, 0xfb, 0xb5, 0x61, 0xd4, 0xa0, 0xfd, 0xe8, 0x06, 0xe3, 0x63, 0x34, 0x50, 0x06,
+	0xd5, 0x8c, 0x7e, 0xa4, 0x01, 0x7e, 0x8c, 0xf6, 0x39, 0x88, 0x9a, 0x55, 0x02, 0x84, 0xdb, 0x53,
+	0x95, 0x5b, 0x02, 0x7f, 0xb5, 0x91, 0x03, 0x94, 0x57, 0x90, 0xcc, 0x3f, 0x01, 0x08, 0xb7, 0x3f,
+	0xee, 0x4d, 0x9c, 0x67, 0x8f, 0x42, 0x1d, 0x6c, 0xd8, 0x06, 0x1b, 0x9a, 0x60, 0xc3, 0x17, 0x2c,
+	0xaf, 0x66, 0xa7, 0x97, 0x2b, 0xdf, 0xba, 0x5e, 0xf9, 0xd8, 0x04, 0x74, 0xdb, 0x1b, 0xfc, 0xfa,
+	0xe3, 0x4f, 0xd2, 0x5c, 0xb6, 0xef, 0xb3, 0x60, 0x25, 0x31, 0x6f, 0xa3, 0x8f, 0x27, 0x22, 0xf9,
+	0x4c, 0xe4, 0xb2, 0x06, 0xa1, 0x64, 0x44, 0x84, 0x74, 0xe7, 0x29, 0x80, 0xc0, 0xdf, 0x6c, 0x74,
+	0x20, 0xe9, 0xc5, 0xfc, 0x4b, 0x2e, 0xb3, 0x0c, 0x8a, 0xc4, 0x1d, 0xdc, 0xe5, 0xe2, 0x95, 0x71,
+	0x61, 0x32, 0xec, 0x36, 0xef, 0x66, 0xc3, 0x91, 0xf4, 0xe2, 0xbd, 0xe9, 0xc4, 0x2e, 0xda, 0x13,
+	0x05, 0x15, 0x19, 0x08, 0x77, 0xa8, 0x82, 0xda, 0x42, 0x0c, 0xdb, 0x4a, 0xe2, 0xee, 0xdd, 0xe5,
+	0xed, 0x69, 0xeb, 0x6d, 0x27, 0x13, 0x5b, 0xed, 0xd9, 0x9b, 0xcb, 0xb5, 0x67, 0x5f, 0xad, 0x3d,
+	0xfb, 0xef, 0xda, 0xb3, 0xbf, 0x6f, 0x3c, 0xeb, 0x6a, 0xe3, 0x59, 0xbf, 0x37, 0x9e, 0xf5, 0x81,
+	0x74, 0xc4, 0xda, 0x25, 0xa8, 0x40, 0x12, 0xb3, 0x0c, 0xa4, 0x64, 0x49, 0x53, 0x80, 0x30, 0xab,
+	0xa2, 0x95, 0xe3, 0xa1, 0xfa, 0xd7, 0x9f, 0xff, 0x1f, 0x00, 0xeb, 0xf1, 0x9a, 0xd5, 0x48, 0x03,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Epoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Epoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Epoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slashed) > 0 {
+		for iNdEx := len(m.Slashed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Slashes != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.Slashes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TaxWithheld) > 0 {
+		for iNdEx := len(m.TaxWithheld) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaxWithheld[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EarnedFees) > 0 {
+		for iNdEx := len(m.EarnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EarnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Responses != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.Responses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintPayout(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPayout(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPayout(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovPayout(uint64(m.EpochLength))
+	}
+	return n
+}
+
+func (m *Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovPayout(uint64(m.Number))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovPayout(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *ProviderReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovPayout(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovPayout(uint64(m.Epoch))
+	}
+	if m.Responses != 0 {
+		n += 1 + sovPayout(uint64(m.Responses))
+	}
+	if len(m.EarnedFees) > 0 {
+		for _, e := range m.EarnedFees {
+			l = e.Size()
+			n += 1 + l + sovPayout(uint64(l))
+		}
+	}
+	if len(m.TaxWithheld) > 0 {
+		for _, e := range m.TaxWithheld {
+			l = e.Size()
+			n += 1 + l + sovPayout(uint64(l))
+		}
+	}
+	if m.Slashes != 0 {
+		n += 1 + sovPayout(uint64(m.Slashes))
+	}
+	if len(m.Slashed) > 0 {
+		for _, e := range m.Slashed {
+			l = e.Size()
+			n += 1 + l + sovPayout(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPayout(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPayout(x uint64) (n int) {
+	return sovPayout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Epoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			m.Responses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Responses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarnedFees = append(m.EarnedFees, types.Coin{})
+			if err := m.EarnedFees[len(m.EarnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaxWithheld", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaxWithheld = append(m.TaxWithheld, types.Coin{})
+			if err := m.TaxWithheld[len(m.TaxWithheld)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			m.Slashes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slashes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashed = append(m.Slashed, types.Coin{})
+			if err := m.Slashed[len(m.Slashed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPayout(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPayout
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPayout
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPayout
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPayout
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPayout        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPayout          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPayout = fmt.Errorf("proto: unexpected end of group")
+)
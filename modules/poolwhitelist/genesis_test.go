@@ -0,0 +1,49 @@
+package poolwhitelist_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/poolwhitelist"
+	"github.com/irisnet/irishub/modules/poolwhitelist/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (suite *TestSuite) TestExportGenesis() {
+	defaultGenesis := types.DefaultGenesisState()
+	exportedGenesis := poolwhitelist.ExportGenesis(suite.ctx, suite.app.PoolWhitelistKeeper)
+	suite.Equal(defaultGenesis, exportedGenesis)
+}
+
+func (suite *TestSuite) TestInitGenesis() {
+	genesis := types.NewGenesisState(
+		types.NewParams(true, []string{"btc"}),
+	)
+	poolwhitelist.InitGenesis(suite.ctx, suite.app.PoolWhitelistKeeper, *genesis)
+
+	exportedGenesis := poolwhitelist.ExportGenesis(suite.ctx, suite.app.PoolWhitelistKeeper)
+	suite.Equal(genesis, exportedGenesis)
+}
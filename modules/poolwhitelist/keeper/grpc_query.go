@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/poolwhitelist/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the poolwhitelist parameters
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParamSet(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Denom queries whether a coinswap pool can be created for a denom
+func (k Keeper) Denom(c context.Context, req *types.QueryDenomRequest) (*types.QueryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.queryDenom(ctx, req.Denom), nil
+}
+
+func (k Keeper) queryDenom(ctx sdk.Context, denom string) *types.QueryDenomResponse {
+	return &types.QueryDenomResponse{
+		Denom:               denom,
+		PoolCreationAllowed: k.IsPoolCreationAllowed(ctx, denom),
+	}
+}
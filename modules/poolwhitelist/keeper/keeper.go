@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/irisnet/irishub/modules/poolwhitelist/types"
+)
+
+// Keeper of the poolwhitelist store
+type Keeper struct {
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper returns a poolwhitelist keeper
+func NewKeeper(paramSpace paramtypes.Subspace) Keeper {
+	return Keeper{
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
+}
+
+// GetParamSet returns poolwhitelist params from the global param store.
+// The params may be absent on chains that added the module by an upgrade,
+// in which case the defaults apply.
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetIfExists(ctx, types.KeyGated, &params.Gated)
+	k.paramSpace.GetIfExists(ctx, types.KeyDenoms, &params.Denoms)
+	return params
+}
+
+// SetParamSet sets poolwhitelist params to the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsPoolCreationAllowed returns true if a new coinswap pool can be created for the given denom
+func (k Keeper) IsPoolCreationAllowed(ctx sdk.Context, denom string) bool {
+	return k.GetParamSet(ctx).IsPoolCreationAllowed(denom)
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/poolwhitelist/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestSetGetParamSet() {
+	params := types.NewParams(true, []string{"btc"})
+	suite.app.PoolWhitelistKeeper.SetParamSet(suite.ctx, params)
+	expParamSet := suite.app.PoolWhitelistKeeper.GetParamSet(suite.ctx)
+
+	require.Equal(suite.T(), params, expParamSet)
+}
+
+func (suite *KeeperTestSuite) TestIsPoolCreationAllowed() {
+	require.True(suite.T(), suite.app.PoolWhitelistKeeper.IsPoolCreationAllowed(suite.ctx, "btc"))
+
+	suite.app.PoolWhitelistKeeper.SetParamSet(suite.ctx, types.NewParams(true, []string{"btc"}))
+	require.True(suite.T(), suite.app.PoolWhitelistKeeper.IsPoolCreationAllowed(suite.ctx, "btc"))
+	require.False(suite.T(), suite.app.PoolWhitelistKeeper.IsPoolCreationAllowed(suite.ctx, "eth"))
+
+	res, err := suite.app.PoolWhitelistKeeper.Denom(sdk.WrapSDKContext(suite.ctx), &types.QueryDenomRequest{Denom: "eth"})
+	require.NoError(suite.T(), err)
+	require.False(suite.T(), res.PoolCreationAllowed)
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// poolwhitelist module sentinel errors
+var (
+	ErrInvalidDenom   = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	ErrPoolNotAllowed = sdkerrors.Register(ModuleName, 3, "pool creation not allowed")
+)
@@ -0,0 +1,17 @@
+package types
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "poolwhitelist"
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the poolwhitelist querier
+	QueryParameters = "parameters"
+	QueryDenom      = "denom"
+)
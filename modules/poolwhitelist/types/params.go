@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store key
+var (
+	// params store for the gating of the coinswap pool creation
+	KeyGated = []byte("Gated")
+	// params store for the denoms approved for new coinswap pools
+	KeyDenoms = []byte("Denoms")
+)
+
+// ParamKeyTable for poolwhitelist module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs Params
+func NewParams(gated bool, denoms []string) Params {
+	return Params{
+		Gated:  gated,
+		Denoms: denoms,
+	}
+}
+
+// DefaultParams returns default poolwhitelist module parameters
+func DefaultParams() Params {
+	return NewParams(false, nil)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyGated, &p.Gated, validateGated),
+		paramtypes.NewParamSetPair(KeyDenoms, &p.Denoms, validateDenoms),
+	}
+}
+
+// GetParamSpace implements params.ParamStruct
+func (p *Params) GetParamSpace() string {
+	return DefaultParamSpace
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	return validateDenoms(p.Denoms)
+}
+
+// IsPoolCreationAllowed returns true if a coinswap pool can be created for the given denom
+func (p Params) IsPoolCreationAllowed(denom string) bool {
+	if !p.Gated {
+		return true
+	}
+
+	for _, d := range p.Denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func validateGated(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+		}
+		if denom == sdk.DefaultBondDenom {
+			return sdkerrors.Wrapf(ErrInvalidDenom, "the staking denom [%s] can not be paired in a pool", denom)
+		}
+		if denoms[denom] {
+			return sdkerrors.Wrapf(ErrInvalidDenom, "duplicate denom [%s]", denom)
+		}
+		denoms[denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"valid denoms", NewParams(true, []string{"btc", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}), true},
+		{"ungated denoms", NewParams(false, []string{"btc"}), true},
+		{"invalid denom", NewParams(true, []string{"1btc"}), false},
+		{"staking denom", NewParams(true, []string{sdk.DefaultBondDenom}), false},
+		{"duplicate denom", NewParams(true, []string{"btc", "btc"}), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestIsPoolCreationAllowed(t *testing.T) {
+	params := NewParams(false, nil)
+	require.True(t, params.IsPoolCreationAllowed("btc"))
+
+	params = NewParams(true, []string{"btc"})
+	require.True(t, params.IsPoolCreationAllowed("btc"))
+	require.False(t, params.IsPoolCreationAllowed("eth"))
+}
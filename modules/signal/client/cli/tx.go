@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/signal/types"
+)
+
+const (
+	FlagContentHash = "content-hash"
+	FlagMetadata    = "metadata"
+)
+
+// GetCmdSubmitProposal implements the command to submit a signal proposal
+func GetCmdSubmitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Submit a signal proposal",
+		Long:  "Submit a signal proposal committing to a document hosted off-chain by its SHA-256 hash, along with an initial deposit.",
+		Example: fmt.Sprintf(
+			`%s tx gov submit-proposal signal --title=<title> --description=<description> --content-hash=<sha256-hex> --metadata='{"url":"<document-url>"}' --deposit=1000iris --from=<key-name>`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			contentHash, err := cmd.Flags().GetString(FlagContentHash)
+			if err != nil {
+				return err
+			}
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSignalProposal(title, description, contentHash, metadata)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(FlagContentHash, "", "hex encoded SHA-256 hash of the document hosted off-chain")
+	cmd.Flags().String(FlagMetadata, "", "JSON object describing the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	_ = cmd.MarkFlagRequired(govcli.FlagTitle)
+	_ = cmd.MarkFlagRequired(govcli.FlagDescription)
+	_ = cmd.MarkFlagRequired(FlagContentHash)
+
+	return cmd
+}
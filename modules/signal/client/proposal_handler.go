@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/irisnet/irishub/modules/signal/client/cli"
+	"github.com/irisnet/irishub/modules/signal/client/rest"
+)
+
+// ProposalHandler is the signal proposal handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
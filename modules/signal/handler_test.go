@@ -0,0 +1,49 @@
+package signal_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/signal/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.app = app
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
+
+func (suite *HandlerTestSuite) TestSubmitProposal() {
+	content := types.NewSignalProposal(
+		"title", "description", hex.EncodeToString(tmhash.Sum([]byte("document"))), `{"url":"https://forum.irisnet.org/t/1"}`,
+	)
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+	suite.NoError(err)
+	suite.Equal(content, proposal.GetContent())
+
+	handler := suite.app.GovKeeper.Router().GetRoute(content.ProposalRoute())
+	suite.NoError(handler(suite.ctx, content))
+	suite.Error(handler(suite.ctx, govtypes.NewTextProposal("title", "description")))
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// signal module sentinel errors
+var (
+	ErrInvalidContentHash = sdkerrors.Register(ModuleName, 2, "invalid content hash")
+	ErrInvalidMetadata    = sdkerrors.Register(ModuleName, 3, "invalid metadata")
+)
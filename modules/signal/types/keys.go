@@ -0,0 +1,10 @@
+package types
+
+// nolint
+const (
+	// ModuleName defines the module name
+	ModuleName = "signal"
+
+	// RouterKey defines the module's proposal routing key
+	RouterKey = ModuleName
+)
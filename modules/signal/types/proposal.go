@@ -0,0 +1,97 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSignal defines the type for a SignalProposal
+	ProposalTypeSignal = "Signal"
+
+	// MaxMetadataLength is the maximum length of the metadata of a signal
+	// proposal, the document it commits to being hosted off-chain
+	MaxMetadataLength = 2048
+)
+
+// Assert SignalProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &SignalProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSignal)
+	govtypes.RegisterProposalTypeCodec(&SignalProposal{}, "irishub/signal/SignalProposal")
+}
+
+// NewSignalProposal creates a new signal proposal
+func NewSignalProposal(title, description, contentHash, metadata string) *SignalProposal {
+	return &SignalProposal{
+		Title:       title,
+		Description: description,
+		ContentHash: contentHash,
+		Metadata:    metadata,
+	}
+}
+
+// GetTitle returns the title of a signal proposal.
+func (sp *SignalProposal) GetTitle() string { return sp.Title }
+
+// GetDescription returns the description of a signal proposal.
+func (sp *SignalProposal) GetDescription() string { return sp.Description }
+
+// ProposalRoute returns the routing key of a signal proposal.
+func (sp *SignalProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a signal proposal.
+func (sp *SignalProposal) ProposalType() string { return ProposalTypeSignal }
+
+// ValidateBasic runs basic stateless validity checks
+func (sp *SignalProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sp); err != nil {
+		return err
+	}
+	if err := ValidateContentHash(sp.ContentHash); err != nil {
+		return err
+	}
+	return ValidateMetadata(sp.Metadata)
+}
+
+// String implements the Stringer interface.
+func (sp SignalProposal) String() string {
+	return fmt.Sprintf(`Signal Proposal:
+  Title:        %s
+  Description:  %s
+  Content Hash: %s
+  Metadata:     %s
+`, sp.Title, sp.Description, sp.ContentHash, sp.Metadata)
+}
+
+// ValidateContentHash verifies whether the given hash is a hex encoded SHA-256 hash
+func ValidateContentHash(hash string) error {
+	bz, err := hex.DecodeString(hash)
+	if err != nil || len(bz) != tmhash.Size {
+		return sdkerrors.Wrapf(ErrInvalidContentHash, "expected a hex encoded SHA-256 hash, got [%s]", hash)
+	}
+	return nil
+}
+
+// ValidateMetadata verifies whether the given metadata is empty or a JSON
+// object of at most MaxMetadataLength bytes
+func ValidateMetadata(metadata string) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "length %d exceeds %d", len(metadata), MaxMetadataLength)
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(metadata), &object); err != nil || object == nil {
+		return sdkerrors.Wrapf(ErrInvalidMetadata, "expected a JSON object, got [%s]", metadata)
+	}
+	return nil
+}
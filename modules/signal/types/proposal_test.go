@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+func TestSignalProposalValidateBasic(t *testing.T) {
+	hash := strings.ToUpper(hashHex("document"))
+	metadata := `{"url":"https://forum.irisnet.org/t/1"}`
+
+	tests := []struct {
+		name     string
+		proposal *SignalProposal
+		expPass  bool
+	}{
+		{"valid", NewSignalProposal("title", "description", hashHex("document"), metadata), true},
+		{"upper case hash", NewSignalProposal("title", "description", hash, metadata), true},
+		{"no metadata", NewSignalProposal("title", "description", hashHex("document"), ""), true},
+		{"empty title", NewSignalProposal("", "description", hashHex("document"), metadata), false},
+		{"no hash", NewSignalProposal("title", "description", "", metadata), false},
+		{"short hash", NewSignalProposal("title", "description", hashHex("document")[:62], metadata), false},
+		{"malformed hash", NewSignalProposal("title", "description", strings.Repeat("z", 64), metadata), false},
+		{"metadata not an object", NewSignalProposal("title", "description", hashHex("document"), `["url"]`), false},
+		{"null metadata", NewSignalProposal("title", "description", hashHex("document"), `null`), false},
+		{"malformed metadata", NewSignalProposal("title", "description", hashHex("document"), `{"url":`), false},
+		{
+			"metadata too long",
+			NewSignalProposal("title", "description", hashHex("document"), `{"url":"`+strings.Repeat("a", MaxMetadataLength)+`"}`),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func hashHex(document string) string {
+	return hex.EncodeToString(tmhash.Sum([]byte(document)))
+}
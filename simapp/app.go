@@ -110,12 +110,49 @@ import (
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
 	tokentypes "github.com/irisnet/irismod/modules/token/types"
 
+	"github.com/irisnet/irishub/modules/blacklist"
+	blacklistkeeper "github.com/irisnet/irishub/modules/blacklist/keeper"
+	blacklisttypes "github.com/irisnet/irishub/modules/blacklist/types"
+	"github.com/irisnet/irishub/modules/blocktime"
+	blocktimekeeper "github.com/irisnet/irishub/modules/blocktime/keeper"
+	blocktimetypes "github.com/irisnet/irishub/modules/blocktime/types"
+	"github.com/irisnet/irishub/modules/dryrun"
+	dryrunkeeper "github.com/irisnet/irishub/modules/dryrun/keeper"
+	"github.com/irisnet/irishub/modules/dustsweep"
+	dustsweepkeeper "github.com/irisnet/irishub/modules/dustsweep/keeper"
+	dustsweeptypes "github.com/irisnet/irishub/modules/dustsweep/types"
+	"github.com/irisnet/irishub/modules/escrow"
+	escrowkeeper "github.com/irisnet/irishub/modules/escrow/keeper"
+	"github.com/irisnet/irishub/modules/faucet"
+	faucetkeeper "github.com/irisnet/irishub/modules/faucet/keeper"
+	faucettypes "github.com/irisnet/irishub/modules/faucet/types"
+	"github.com/irisnet/irishub/modules/featuregate"
+	featuregatekeeper "github.com/irisnet/irishub/modules/featuregate/keeper"
+	featuregatetypes "github.com/irisnet/irishub/modules/featuregate/types"
+	"github.com/irisnet/irishub/modules/govdeposit"
+	govdepositkeeper "github.com/irisnet/irishub/modules/govdeposit/keeper"
+	govdeposittypes "github.com/irisnet/irishub/modules/govdeposit/types"
+	"github.com/irisnet/irishub/modules/govquorum"
+	govquorumkeeper "github.com/irisnet/irishub/modules/govquorum/keeper"
+	govquorumtypes "github.com/irisnet/irishub/modules/govquorum/types"
 	"github.com/irisnet/irishub/modules/guardian"
 	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
 	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 	"github.com/irisnet/irishub/modules/mint"
 	mintkeeper "github.com/irisnet/irishub/modules/mint/keeper"
 	minttypes "github.com/irisnet/irishub/modules/mint/types"
+	"github.com/irisnet/irishub/modules/paramhistory"
+	paramhistorykeeper "github.com/irisnet/irishub/modules/paramhistory/keeper"
+	paramhistorytypes "github.com/irisnet/irishub/modules/paramhistory/types"
+	"github.com/irisnet/irishub/modules/payout"
+	payoutkeeper "github.com/irisnet/irishub/modules/payout/keeper"
+	payouttypes "github.com/irisnet/irishub/modules/payout/types"
+	"github.com/irisnet/irishub/modules/poolwhitelist"
+	poolwhitelistkeeper "github.com/irisnet/irishub/modules/poolwhitelist/keeper"
+	poolwhitelisttypes "github.com/irisnet/irishub/modules/poolwhitelist/types"
+	"github.com/irisnet/irishub/modules/signal"
+	signalclient "github.com/irisnet/irishub/modules/signal/client"
+	signaltypes "github.com/irisnet/irishub/modules/signal/types"
 )
 
 const appName = "SimApp"
@@ -137,6 +174,7 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			signalclient.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -155,6 +193,19 @@ var (
 		service.AppModuleBasic{},
 		oracle.AppModuleBasic{},
 		random.AppModuleBasic{},
+		featuregate.AppModuleBasic{},
+		paramhistory.AppModuleBasic{},
+		faucet.AppModuleBasic{},
+		dryrun.AppModuleBasic{},
+		signal.AppModuleBasic{},
+		blacklist.AppModuleBasic{},
+		poolwhitelist.AppModuleBasic{},
+		blocktime.AppModuleBasic{},
+		escrow.AppModuleBasic{},
+		payout.AppModuleBasic{},
+		govdeposit.AppModuleBasic{},
+		govquorum.AppModuleBasic{},
+		dustsweep.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -172,6 +223,7 @@ var (
 		servicetypes.DepositAccName:    {authtypes.Burner},
 		servicetypes.RequestAccName:    nil,
 		servicetypes.TaxAccName:        {authtypes.Burner},
+		faucettypes.ModuleName:         nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -229,6 +281,19 @@ type SimApp struct {
 	OracleKeeper   oracleKeeper.Keeper
 	RandomKeeper   randomkeeper.Keeper
 
+	FeatureGateKeeper   featuregatekeeper.Keeper
+	BlacklistKeeper     blacklistkeeper.Keeper
+	PoolWhitelistKeeper poolwhitelistkeeper.Keeper
+	BlockTimeKeeper     blocktimekeeper.Keeper
+	FaucetKeeper        faucetkeeper.Keeper
+	ParamHistoryKeeper  paramhistorykeeper.Keeper
+	DryRunKeeper        dryrunkeeper.Keeper
+	EscrowKeeper        escrowkeeper.Keeper
+	PayoutKeeper        payoutkeeper.Keeper
+	GovDepositKeeper    govdepositkeeper.Keeper
+	GovQuorumKeeper     govquorumkeeper.Keeper
+	DustSweepKeeper     dustsweepkeeper.Keeper
+
 	// the module manager
 	mm *module.Manager
 
@@ -267,6 +332,8 @@ func NewSimApp(
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, capabilitytypes.StoreKey,
 		guardiantypes.StoreKey, tokentypes.StoreKey, nfttypes.StoreKey, htlctypes.StoreKey, recordtypes.StoreKey,
 		coinswaptypes.StoreKey, servicetypes.StoreKey, oracletypes.StoreKey, randomtypes.StoreKey,
+		paramhistorytypes.StoreKey, faucettypes.StoreKey, blocktimetypes.StoreKey, payouttypes.StoreKey,
+		govquorumtypes.StoreKey, dustsweeptypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -305,9 +372,11 @@ func NewSimApp(
 	StakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
+	app.FeatureGateKeeper = featuregatekeeper.NewKeeper(app.GetSubspace(featuregatetypes.ModuleName))
+	app.BlockTimeKeeper = blocktimekeeper.NewKeeper(keys[blocktimetypes.StoreKey])
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
+		app.AccountKeeper, app.BankKeeper, newMintBlockTimeOracle(app.FeatureGateKeeper, app.BlockTimeKeeper), authtypes.FeeCollectorName,
 	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
@@ -332,17 +401,25 @@ func NewSimApp(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, scopedIBCKeeper,
 	)
 
+	app.ParamHistoryKeeper = paramhistorykeeper.NewKeeper(
+		appCodec, keys[paramhistorytypes.StoreKey], app.ParamsKeeper,
+	)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, paramhistory.NewParamChangeProposalHandler(
+			app.ParamHistoryKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper),
+		)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(signaltypes.RouterKey, signal.NewProposalHandler()).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.ClientKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&StakingKeeper, govRouter,
 	)
+	app.DryRunKeeper = dryrunkeeper.NewKeeper(app.GovKeeper)
 
 	// Create Transfer Keepers
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -369,6 +446,12 @@ func NewSimApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
+	app.BlacklistKeeper = blacklistkeeper.NewKeeper(app.GetSubspace(blacklisttypes.ModuleName))
+	app.PoolWhitelistKeeper = poolwhitelistkeeper.NewKeeper(app.GetSubspace(poolwhitelisttypes.ModuleName))
+	app.FaucetKeeper = faucetkeeper.NewKeeper(
+		appCodec, keys[faucettypes.StoreKey], app.GetSubspace(faucettypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper,
+	)
 	app.GuardianKeeper = guardiankeeper.NewKeeper(appCodec, keys[guardiantypes.StoreKey])
 	app.TokenKeeper = tokenkeeper.NewKeeper(
 		appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName),
@@ -397,6 +480,21 @@ func NewSimApp(
 
 	app.RandomKeeper = randomkeeper.NewKeeper(appCodec, keys[randomtypes.StoreKey], app.BankKeeper, app.ServiceKeeper)
 
+	app.EscrowKeeper = escrowkeeper.NewKeeper(
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.GovKeeper, app.ServiceKeeper, app.HTLCKeeper,
+	)
+
+	app.PayoutKeeper = payoutkeeper.NewKeeper(appCodec, keys[payouttypes.StoreKey], app.GetSubspace(payouttypes.ModuleName))
+	app.GovDepositKeeper = govdepositkeeper.NewKeeper(
+		app.GetSubspace(govdeposittypes.ModuleName), app.BankKeeper, app.GovKeeper,
+	)
+	app.GovQuorumKeeper = govquorumkeeper.NewKeeper(
+		appCodec, keys[govquorumtypes.StoreKey], app.GetSubspace(govquorumtypes.ModuleName), app.GovKeeper,
+	)
+	app.DustSweepKeeper = dustsweepkeeper.NewKeeper(
+		appCodec, keys[dustsweeptypes.StoreKey], app.GetSubspace(dustsweeptypes.ModuleName), app.BankKeeper, app.DistrKeeper,
+	)
+
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -415,7 +513,10 @@ func NewSimApp(
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
-		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		newGovModule(
+			gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+			app.GovKeeper, app.GovDepositKeeper, app.GovQuorumKeeper,
+		),
 		mint.NewAppModule(appCodec, app.MintKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
@@ -434,6 +535,18 @@ func NewSimApp(
 		service.NewAppModule(appCodec, app.ServiceKeeper, app.AccountKeeper, app.BankKeeper),
 		oracle.NewAppModule(appCodec, app.OracleKeeper),
 		random.NewAppModule(appCodec, app.RandomKeeper, app.AccountKeeper, app.BankKeeper),
+		featuregate.NewAppModule(appCodec, app.FeatureGateKeeper),
+		paramhistory.NewAppModule(appCodec, app.ParamHistoryKeeper),
+		faucet.NewAppModule(appCodec, app.FaucetKeeper),
+		dryrun.NewAppModule(app.DryRunKeeper),
+		blacklist.NewAppModule(appCodec, app.BlacklistKeeper),
+		poolwhitelist.NewAppModule(appCodec, app.PoolWhitelistKeeper),
+		blocktime.NewAppModule(app.BlockTimeKeeper),
+		escrow.NewAppModule(app.EscrowKeeper),
+		payout.NewAppModule(appCodec, app.PayoutKeeper),
+		govdeposit.NewAppModule(appCodec, app.GovDepositKeeper),
+		govquorum.NewAppModule(appCodec, app.GovQuorumKeeper),
+		dustsweep.NewAppModule(appCodec, app.DustSweepKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -441,13 +554,13 @@ func NewSimApp(
 	// CanWithdrawInvariant invariant.
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName,
+		upgradetypes.ModuleName, blocktimetypes.ModuleName, payouttypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName,
 		slashingtypes.ModuleName, evidencetypes.ModuleName, stakingtypes.ModuleName,
 		ibchost.ModuleName, htlctypes.ModuleName, randomtypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
-		servicetypes.ModuleName,
+		servicetypes.ModuleName, dustsweeptypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -461,6 +574,9 @@ func NewSimApp(
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, ibctransfertypes.ModuleName,
 		guardiantypes.ModuleName, tokentypes.ModuleName, nfttypes.ModuleName, htlctypes.ModuleName, recordtypes.ModuleName,
 		coinswaptypes.ModuleName, servicetypes.ModuleName, oracletypes.ModuleName, randomtypes.ModuleName,
+		featuregatetypes.ModuleName, paramhistorytypes.ModuleName, faucettypes.ModuleName, blacklisttypes.ModuleName,
+		poolwhitelisttypes.ModuleName, payouttypes.ModuleName, govdeposittypes.ModuleName, govquorumtypes.ModuleName,
+		dustsweeptypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -729,6 +845,14 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(coinswaptypes.ModuleName)
 	paramsKeeper.Subspace(servicetypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(featuregatetypes.ModuleName)
+	paramsKeeper.Subspace(faucettypes.ModuleName)
+	paramsKeeper.Subspace(blacklisttypes.ModuleName)
+	paramsKeeper.Subspace(poolwhitelisttypes.ModuleName)
+	paramsKeeper.Subspace(payouttypes.ModuleName)
+	paramsKeeper.Subspace(govdeposittypes.ModuleName)
+	paramsKeeper.Subspace(govquorumtypes.ModuleName)
+	paramsKeeper.Subspace(dustsweeptypes.ModuleName)
 
 	return paramsKeeper
 }
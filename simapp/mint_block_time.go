@@ -0,0 +1,40 @@
+package simapp
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	blocktimekeeper "github.com/irisnet/irishub/modules/blocktime/keeper"
+	featuregatekeeper "github.com/irisnet/irishub/modules/featuregate/keeper"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
+)
+
+// FeatureMeasuredBlockTime is the feature gate name enabling the block provisions
+// of the mint module to follow the measured median block time
+const FeatureMeasuredBlockTime = "measured-block-time"
+
+var _ minttypes.BlockTimeOracle = mintBlockTimeOracle{}
+
+// mintBlockTimeOracle provides the mint module with the median block time
+// measured by the blocktime module once the feature is active, and with the
+// default block time before, so that the blocks minted earlier replay unchanged
+type mintBlockTimeOracle struct {
+	fk  featuregatekeeper.Keeper
+	btk blocktimekeeper.Keeper
+}
+
+func newMintBlockTimeOracle(fk featuregatekeeper.Keeper, btk blocktimekeeper.Keeper) mintBlockTimeOracle {
+	return mintBlockTimeOracle{
+		fk:  fk,
+		btk: btk,
+	}
+}
+
+// MedianBlockTime implements minttypes.BlockTimeOracle
+func (o mintBlockTimeOracle) MedianBlockTime(ctx sdk.Context) time.Duration {
+	if !o.fk.IsFeatureActive(ctx, FeatureMeasuredBlockTime) {
+		return minttypes.DefaultBlockTime
+	}
+	return o.btk.MedianBlockTime(ctx)
+}